@@ -11,20 +11,21 @@ type Model struct {
 }
 
 type Item struct {
-	title string
+	title       string
+	description string
 }
 
 func (i Item) Title() string       { return i.title }
-func (i Item) Description() string { return "" }
+func (i Item) Description() string { return i.description }
 func (i Item) FilterValue() string { return "" }
 
 var items = []Item{
-	{title: "Red"},
-	{title: "Green"},
-	{title: "Yellow"},
-	{title: "Blue"},
-	{title: "Magenta"},
-	{title: "Cyan"},
+	{title: "Red", description: "primary"},
+	{title: "Green", description: "primary"},
+	{title: "Yellow", description: "secondary"},
+	{title: "Blue", description: "primary"},
+	{title: "Magenta", description: "secondary"},
+	{title: "Cyan", description: "secondary"},
 }
 
 var (
@@ -97,6 +98,7 @@ func Settings(fl filterlist.Model) filterlist.Model {
 	fl.TextInput.Placeholder = defaultPlaceholder
 	fl.TextInput.PromptMark = defaultPromptMark
 	fl.TextInput.PromptText = defaultPromptText
+	fl.Weights = filterlist.Weights{Title: 2, Description: 1}
 
 	return fl
 }
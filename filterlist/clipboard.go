@@ -0,0 +1,128 @@
+package filterlist
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	osc52 "github.com/aymanbagabas/go-osc52/v2"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Clipboard is the clipboard model. Text is copied to the system clipboard
+// with an OSC 52 escape sequence which is supported over SSH.
+type Clipboard struct {
+	// Passthrough wraps the escape sequence to be passed through a
+	// terminal multiplexer to the terminal. The multiplexer is detected
+	// from the environment when not set.
+	Passthrough Passthrough
+
+	// Writer the escape sequence is written to. Standard output is used
+	// when not set. The sequence is written outside of the Bubble Tea
+	// renderer so it may be interleaved with a frame being drawn unless
+	// the writer is synchronised with the program output.
+	Writer io.Writer
+
+	// Fallback is called with the text when the escape sequence could
+	// not be written. Terminals do not report whether the sequence was
+	// understood so writing to a terminal rarely fails and the fallback
+	// is not called when the terminal ignores the sequence.
+	Fallback func(string) error
+}
+
+// Passthrough is the terminal multiplexer the escape sequence is passed
+// through.
+type Passthrough int
+
+const (
+	// PassthroughAuto detects tmux or screen from the environment.
+	PassthroughAuto Passthrough = iota
+
+	// PassthroughNone writes the escape sequence unchanged.
+	PassthroughNone
+
+	// PassthroughTmux wraps the escape sequence for tmux. The
+	// allow-passthrough option of tmux must be enabled.
+	PassthroughTmux
+
+	// PassthroughScreen wraps the escape sequence for screen.
+	PassthroughScreen
+)
+
+// CopyMsg is sent when text has been copied to the clipboard.
+type CopyMsg struct {
+	Text string
+	Err  error
+}
+
+// Copy copies the marked items to the clipboard, one per line. The
+// highlighted item is copied when no items are marked.
+func (m Model) Copy() tea.Cmd {
+	var titles []string
+
+	for _, i := range m.Marked() {
+		titles = append(titles, itemTitle(i))
+	}
+
+	if len(titles) == 0 && m.SelectedItem() != nil {
+		titles = append(titles, itemTitle(m.SelectedItem()))
+	}
+
+	if len(titles) == 0 {
+		return nil
+	}
+
+	text := strings.Join(titles, "\n")
+	cb := m.Clipboard
+
+	return func() tea.Msg {
+		return CopyMsg{
+			Text: text,
+			Err:  cb.copy(text),
+		}
+	}
+}
+
+// copy writes the escape sequence and uses the fallback if it fails.
+func (c Clipboard) copy(text string) error {
+	w := c.Writer
+	if w == nil {
+		w = os.Stdout
+	}
+
+	_, err := osc52.New(text).Mode(c.Passthrough.mode()).WriteTo(w)
+	if err != nil && c.Fallback != nil {
+		return c.Fallback(text)
+	}
+
+	return err
+}
+
+// mode is the mode of the escape sequence for the terminal multiplexer.
+func (p Passthrough) mode() osc52.Mode {
+	if p == PassthroughAuto {
+		p = detectPassthrough()
+	}
+
+	switch p {
+	case PassthroughTmux:
+		return osc52.TmuxMode
+	case PassthroughScreen:
+		return osc52.ScreenMode
+	default:
+		return osc52.DefaultMode
+	}
+}
+
+// detectPassthrough detects the terminal multiplexer the program is
+// running within from the environment.
+func detectPassthrough() Passthrough {
+	switch {
+	case os.Getenv("TMUX") != "":
+		return PassthroughTmux
+	case strings.HasPrefix(os.Getenv("TERM"), "screen"):
+		return PassthroughScreen
+	default:
+		return PassthroughNone
+	}
+}
@@ -0,0 +1,296 @@
+package filterlist
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Command is the command item source model. The standard output of the
+// command is streamed into the list with one item per line. A running
+// command must be stopped with StopCommand once the model is discarded.
+type Command struct {
+	// Name of the command to run.
+	Name string
+
+	// Arguments passed to the command. Any occurrence of the query
+	// placeholder is replaced with the filter text.
+	Args []string
+
+	// Working directory of the command.
+	Dir string
+
+	// Reload re-runs the command whenever the filter text changes. Without
+	// reloading the output is only filtered by the text input when weights
+	// are set.
+	Reload bool
+
+	Styles CommandStyles
+}
+
+// CommandStyles is the styling of the command status area.
+type CommandStyles struct {
+	// Status of a running or successful command.
+	Status lipgloss.Style
+
+	// Status of a failed command.
+	Error lipgloss.Style
+}
+
+// CommandItem is a single line of command output.
+type CommandItem string
+
+// CommandOutputMsg is sent when the command has written lines to
+// standard output.
+type CommandOutputMsg struct {
+	ID    int
+	Lines []string
+}
+
+// CommandExitMsg is sent when the command has exited.
+type CommandExitMsg struct {
+	ID     int
+	Err    error
+	Stderr string
+}
+
+// commandRun is a single execution of a command.
+type commandRun struct {
+	id     int
+	cancel context.CancelFunc
+	lines  chan string
+	err    error
+	stderr bytes.Buffer
+}
+
+const (
+	// CommandQuery is the argument placeholder replaced with the filter text.
+	CommandQuery = "{q}"
+
+	// Maximum number of lines added to the list for each message.
+	commandBatch = 100
+)
+
+func (i CommandItem) Title() string       { return string(i) }
+func (i CommandItem) Description() string { return "" }
+func (i CommandItem) FilterValue() string { return string(i) }
+
+// RunCommand starts the command and replaces the list items with its output.
+// Any command that is already running is cancelled.
+func (m *Model) RunCommand() tea.Cmd {
+	m.StopCommand()
+
+	if m.Command.Name == "" {
+		return nil
+	}
+
+	var id int
+	if m.command != nil {
+		id = m.command.id
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	//nolint:gosec
+	cmd := exec.CommandContext(ctx, m.Command.Name, commandArgs(m.Command.Args, m.Filter())...)
+	cmd.Dir = m.Command.Dir
+
+	run := &commandRun{
+		id:     id + 1,
+		cancel: cancel,
+		lines:  make(chan string, commandBatch),
+	}
+	cmd.Stderr = &run.stderr
+
+	m.command = run
+	m.commandStatus = "running"
+	m.commandFailed = false
+
+	stdout, err := cmd.StdoutPipe()
+	if err == nil {
+		err = cmd.Start()
+	}
+
+	if err != nil {
+		cancel()
+		run.err = err
+		close(run.lines)
+
+		return tea.Batch(m.SetItems(nil), run.wait())
+	}
+
+	go func() {
+		readErr := run.read(ctx, stdout)
+
+		run.err = cmd.Wait()
+		if run.err == nil {
+			run.err = readErr
+		}
+
+		close(run.lines)
+	}()
+
+	return tea.Batch(m.SetItems(nil), run.wait())
+}
+
+// CommandRunning returns whether the command is still running.
+func (m Model) CommandRunning() bool {
+	return m.command != nil && m.command.cancel != nil
+}
+
+// updateCommand handles the output and exit of the command. Messages from
+// previous runs of the command are discarded.
+func (m *Model) updateCommand(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case CommandOutputMsg:
+		if !m.isCommand(msg.ID) {
+			return nil
+		}
+
+		// Output received after the command was stopped is discarded
+		// while waiting for it to exit.
+		if !m.CommandRunning() {
+			return m.command.wait()
+		}
+
+		items := m.items
+		for _, l := range msg.Lines {
+			items = append(items, CommandItem(l))
+		}
+
+		return tea.Batch(m.SetItems(items), m.command.wait())
+
+	case CommandExitMsg:
+		if !m.isCommand(msg.ID) {
+			return nil
+		}
+
+		m.command.cancel = nil
+		m.commandStatus = commandStatus(len(m.items), msg.Err, msg.Stderr)
+		m.commandFailed = msg.Err != nil
+	}
+
+	return nil
+}
+
+// isCommand determines whether the ID belongs to the current run.
+func (m Model) isCommand(id int) bool {
+	return m.command != nil && m.command.id == id
+}
+
+// StopCommand cancels the current command if it is running. The command
+// is not stopped when the model is discarded so it must be stopped before
+// the model is no longer used.
+func (m *Model) StopCommand() {
+	if m.command == nil || m.command.cancel == nil {
+		return
+	}
+
+	m.command.cancel()
+	m.command.cancel = nil
+}
+
+// commandView renders the status area of the command. The status is
+// truncated to the width of the list.
+func (m Model) commandView() string {
+	style := m.Command.Styles.Status
+	if m.commandFailed {
+		style = m.Command.Styles.Error
+	}
+
+	return style.Copy().MaxWidth(m.list.Width()).Render(m.commandStatus)
+}
+
+// read sends each line of the output until the end is reached. Lines are
+// not limited in length. If reading fails the remaining output is discarded
+// so the command is not blocked writing and can exit.
+func (r *commandRun) read(ctx context.Context, stdout io.Reader) error {
+	reader := bufio.NewReader(stdout)
+
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			// Lines are discarded once cancelled so the output is
+			// still drained and the command can exit.
+			select {
+			case r.lines <- strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"):
+			case <-ctx.Done():
+			}
+		}
+
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil
+		case err != nil:
+			//nolint:errcheck
+			io.Copy(io.Discard, stdout)
+
+			return err
+		}
+	}
+}
+
+// wait returns a command that waits for the next lines of output. All
+// buffered lines are returned together to reduce the number of updates.
+func (r *commandRun) wait() tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-r.lines
+		if !ok {
+			return CommandExitMsg{
+				ID:     r.id,
+				Err:    r.err,
+				Stderr: r.stderr.String(),
+			}
+		}
+
+		lines := []string{line}
+
+		for len(lines) < commandBatch {
+			select {
+			case l, ok := <-r.lines:
+				if !ok {
+					return CommandOutputMsg{ID: r.id, Lines: lines}
+				}
+
+				lines = append(lines, l)
+			default:
+				return CommandOutputMsg{ID: r.id, Lines: lines}
+			}
+		}
+
+		return CommandOutputMsg{ID: r.id, Lines: lines}
+	}
+}
+
+// commandArgs replaces the query placeholder in the arguments.
+func commandArgs(args []string, query string) []string {
+	as := make([]string, len(args))
+	for idx, a := range args {
+		as[idx] = strings.ReplaceAll(a, CommandQuery, query)
+	}
+
+	return as
+}
+
+// commandStatus describes the exit of the command. The last line written to
+// standard error is included when the command fails.
+func commandStatus(items int, err error, stderr string) string {
+	if err == nil {
+		return fmt.Sprintf("%d items", items)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stderr), "\n")
+	if last := lines[len(lines)-1]; last != "" {
+		return fmt.Sprintf("%v: %v", err, last)
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,64 @@
+package filterlist
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Complete completes the filter text to the longest common prefix of the
+// item titles that start with the filter text. When the filter text cannot
+// be extended it is completed to the highlighted item.
+func (m *Model) Complete() {
+	filter := m.Filter()
+
+	var titles []string
+
+	for _, i := range m.items {
+		if title := itemTitle(i); hasPrefixFold(title, filter) {
+			titles = append(titles, title)
+		}
+	}
+
+	var prefix string
+
+	for idx, t := range titles {
+		if idx == 0 {
+			prefix = t
+
+			continue
+		}
+
+		prefix = commonPrefixFold(prefix, t)
+	}
+
+	switch {
+	case utf8.RuneCountInString(prefix) > utf8.RuneCountInString(filter):
+		m.textInput.SetValue(prefix)
+	case m.SelectedItem() != nil:
+		m.textInput.SetValue(itemTitle(m.SelectedItem()))
+	default:
+		return
+	}
+
+	m.textInput.CursorEnd()
+}
+
+// hasPrefixFold tests whether the string begins with the prefix ignoring case.
+func hasPrefixFold(s, prefix string) bool {
+	return utf8.RuneCountInString(commonPrefixFold(s, prefix)) == utf8.RuneCountInString(prefix)
+}
+
+// commonPrefixFold returns the longest prefix of a that is shared with b
+// ignoring case.
+func commonPrefixFold(a, b string) string {
+	ar := []rune(a)
+	br := []rune(b)
+
+	for idx, r := range ar {
+		if idx >= len(br) || !strings.EqualFold(string(r), string(br[idx])) {
+			return string(ar[:idx])
+		}
+	}
+
+	return a
+}
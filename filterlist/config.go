@@ -0,0 +1,64 @@
+package filterlist
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// config is the configuration of the model used to set the components.
+// The components are only set again when the configuration changes. Styles
+// cannot be compared cheaply so only the options that are not styles are
+// part of the configuration and style changes are applied with Restyle.
+type config struct {
+	width           int
+	height          int
+	command         bool
+	textInputWidth  int
+	promptMark      string
+	promptText      string
+	placeholder     string
+	charLimit       int
+	listWidth       int
+	listHeight      int
+	wrap            bool
+	itemPrompt      lipgloss.Border
+	itemIndicator   string
+	markIndicator   string
+	actionIndicator string
+}
+
+// config returns the current configuration of the model.
+func (m Model) config() config {
+	return config{
+		width:           m.Width,
+		height:          m.Height,
+		command:         m.Command.Name != "",
+		textInputWidth:  m.TextInput.Width,
+		promptMark:      m.TextInput.PromptMark,
+		promptText:      m.TextInput.PromptText,
+		placeholder:     m.TextInput.Placeholder,
+		charLimit:       m.TextInput.CharLimit,
+		listWidth:       m.List.Width,
+		listHeight:      m.List.Height,
+		wrap:            m.List.Wrap,
+		itemPrompt:      m.List.Styles.ItemPrompt,
+		itemIndicator:   m.List.Styles.ItemIndicator,
+		markIndicator:   m.List.Styles.MarkIndicator,
+		actionIndicator: m.Menu.Styles.ActionIndicator,
+	}
+}
+
+// Restyle applies the styles again on the next update. Styles are applied
+// on the first update and must be restyled when changed afterwards.
+func (m *Model) Restyle() {
+	m.restyle = true
+}
+
+// changed determines whether the configuration differs from the
+// configuration the components were set with.
+func (m Model) changed() bool {
+	if m.applied == nil || m.restyle {
+		return true
+	}
+
+	return m.config() != *m.applied
+}
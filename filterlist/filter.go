@@ -0,0 +1,194 @@
+package filterlist
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Weights are the weights of the item fields matched against the filter
+// text. When any weight is set the list is filtered by the text input and
+// items matching a field with a higher weight are ranked first. Fields
+// without a weight are not matched.
+type Weights struct {
+	// Title of default items or the filter value of other items.
+	Title int
+
+	// Description of default items.
+	Description int
+
+	// Keywords of keyword items.
+	Keywords int
+}
+
+// KeywordItem is an item with additional keywords that can be matched
+// against the filter text.
+type KeywordItem interface {
+	list.Item
+	Keywords() []string
+}
+
+// rank is the position of an item in the filtered items.
+type rank struct {
+	index    int
+	weight   int
+	position int
+}
+
+// Items returns all items regardless of the filter.
+func (m Model) Items() []list.Item {
+	return m.items
+}
+
+// filterItems returns the items that match the filter text in their
+// original order. Items are filtered by the same rule as the list.
+func (m Model) filterItems(items []list.Item) []list.Item {
+	if !m.filtered() || len(items) == 0 {
+		return items
+	}
+
+	ranks := m.rankItems(items)
+	sort.Slice(ranks, func(i, j int) bool {
+		return ranks[i].index < ranks[j].index
+	})
+
+	return rankedItems(items, ranks)
+}
+
+// matchItems returns the items that match the filter text ordered by the
+// weight of the matched field. Items are only filtered when weights are
+// set and the command is not reloaded with the filter text.
+func (m Model) matchItems(items []list.Item) []list.Item {
+	if !m.filtered() || len(items) == 0 {
+		return items
+	}
+
+	return rankedItems(items, m.rankItems(items))
+}
+
+// filtered returns whether the items are filtered by the filter text.
+func (m Model) filtered() bool {
+	return m.Weights.enabled() && !m.Command.Reload && m.Filter() != ""
+}
+
+// setMatches sets the list to the items that match the filter text and
+// highlights the first item.
+func (m *Model) setMatches() tea.Cmd {
+	if !m.Weights.enabled() || m.Command.Reload {
+		return nil
+	}
+
+	cmd := m.list.SetItems(m.matchItems(m.items))
+	m.list.ResetSelected()
+	m.setWrap()
+
+	return cmd
+}
+
+// rankItems ranks the items that match the filter text. Each item is
+// ranked by the highest weighted field that matches and then by how
+// closely the field matches.
+func (m Model) rankItems(items []list.Item) []rank {
+	if !m.Weights.enabled() {
+		return m.rankField(items, 1, func(i list.Item) string {
+			return i.FilterValue()
+		})
+	}
+
+	fields := []struct {
+		weight int
+		value  func(list.Item) string
+	}{
+		{m.Weights.Title, itemTitle},
+		{m.Weights.Description, itemDescription},
+		{m.Weights.Keywords, itemKeywords},
+	}
+
+	best := make(map[int]rank)
+
+	for _, f := range fields {
+		if f.weight <= 0 {
+			continue
+		}
+
+		for _, r := range m.rankField(items, f.weight, f.value) {
+			if b, ok := best[r.index]; !ok || r.weight > b.weight {
+				best[r.index] = r
+			}
+		}
+	}
+
+	ranks := make([]rank, 0, len(best))
+	for _, r := range best {
+		ranks = append(ranks, r)
+	}
+
+	sort.Slice(ranks, func(i, j int) bool {
+		switch {
+		case ranks[i].weight != ranks[j].weight:
+			return ranks[i].weight > ranks[j].weight
+		case ranks[i].position != ranks[j].position:
+			return ranks[i].position < ranks[j].position
+		default:
+			return ranks[i].index < ranks[j].index
+		}
+	})
+
+	return ranks
+}
+
+// rankField ranks the items where the field matches the filter text.
+func (m Model) rankField(items []list.Item, weight int, value func(list.Item) string) []rank {
+	targets := make([]string, len(items))
+	for idx, i := range items {
+		targets[idx] = value(i)
+	}
+
+	matches := m.list.Filter(m.Filter(), targets)
+
+	ranks := make([]rank, len(matches))
+	for idx, r := range matches {
+		ranks[idx] = rank{
+			index:    r.Index,
+			weight:   weight,
+			position: idx,
+		}
+	}
+
+	return ranks
+}
+
+// enabled returns whether any field has a weight.
+func (w Weights) enabled() bool {
+	return w.Title > 0 || w.Description > 0 || w.Keywords > 0
+}
+
+// rankedItems returns the items in the order of the ranks.
+func rankedItems(items []list.Item, ranks []rank) []list.Item {
+	matches := make([]list.Item, len(ranks))
+	for idx, r := range ranks {
+		matches[idx] = items[r.index]
+	}
+
+	return matches
+}
+
+// itemDescription returns the description of an item.
+func itemDescription(item list.Item) string {
+	if i, ok := item.(list.DefaultItem); ok {
+		return i.Description()
+	}
+
+	return ""
+}
+
+// itemKeywords returns the keywords of an item separated by spaces.
+func itemKeywords(item list.Item) string {
+	if i, ok := item.(KeywordItem); ok {
+		return strings.Join(i.Keywords(), " ")
+	}
+
+	return ""
+}
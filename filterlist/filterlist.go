@@ -15,6 +15,16 @@ type Model struct {
 	// Height of the model.
 	Height int
 
+	// AutoSize sets the width and height of the model from the window size.
+	AutoSize bool
+
+	// Constraints of the width and height when the size is set. Zero is
+	// unconstrained.
+	MinWidth  int
+	MaxWidth  int
+	MinHeight int
+	MaxHeight int
+
 	// Text input options.
 	TextInput TextInput
 
@@ -24,10 +34,41 @@ type Model struct {
 	// Paginator options.
 	Paginator Paginator
 
-	focus        bool
-	textInput    textinput.Model
-	list         list.Model
-	selectedItem list.Item
+	// Command item source options.
+	Command Command
+
+	// Action menu options.
+	Menu Menu
+
+	// Pinned items options.
+	Pins Pins
+
+	// Clipboard options.
+	Clipboard Clipboard
+
+	// Filter field weights.
+	Weights Weights
+
+	focus         bool
+	textInput     textinput.Model
+	list          list.Model
+	items         []list.Item
+	selectedItem  list.Item
+	command       *commandRun
+	commandStatus string
+	commandFailed bool
+	menuOpen      bool
+	menuIndex     int
+	pins          []string
+	pinned        []list.Item
+	wrap          wrapLayout
+	pinSelected   bool
+	pinIndex      int
+	marks         marks
+	jumping       bool
+	jump          string
+	applied       *config
+	restyle       bool
 }
 
 const (
@@ -45,12 +86,20 @@ func New() Model {
 	l := List{
 		Styles: ListStyles{
 			ItemIndicator: defaultItemIndicator,
+			MarkIndicator: defaultMarkIndicator,
+		},
+	}
+
+	mo := Menu{
+		Styles: MenuStyles{
+			ActionIndicator: defaultActionIndicator,
 		},
 	}
 
 	return Model{
 		List:      l,
 		TextInput: ti,
+		Menu:      mo,
 		Width:     defaultWidth,
 		Height:    defaultHeight,
 
@@ -64,18 +113,91 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
 
+	// Resize to the window regardless of focus.
+	if msgType, ok := msg.(tea.WindowSizeMsg); ok && m.AutoSize {
+		m.SetSize(msgType.Width, msgType.Height)
+	}
+
 	cmds = append(cmds, m.setModels())
 
+	// Command output is received regardless of focus.
+	cmds = append(cmds, m.updateCommand(msg))
+
 	if !m.focus {
 		return m, tea.Batch(cmds...)
 	}
 
+	// The action menu receives all keyboard input while open.
+	if m.menuOpen {
+		cmds = append(cmds, m.updateMenu(msg))
+
+		return m, tea.Batch(cmds...)
+	}
+
+	// The page number receives all keyboard input while being entered.
+	if m.jumping {
+		m.updateJump(msg)
+
+		return m, tea.Batch(cmds...)
+	}
+
+	filter := m.Filter()
+
 	//nolint:gocritic
 	switch msgType := msg.(type) {
 	case tea.KeyMsg:
 		switch msgType.String() {
+		case "home":
+			m.SelectFirst()
+
+			return m, tea.Batch(cmds...)
+		case "end":
+			m.SelectLast()
+
+			return m, tea.Batch(cmds...)
+		case "shift+down":
+			m.HalfPageDown()
+
+			return m, tea.Batch(cmds...)
+		case "shift+up":
+			m.HalfPageUp()
+
+			return m, tea.Batch(cmds...)
+		case "ctrl+g":
+			m.jumping = true
+			m.jump = ""
+
+			return m, tea.Batch(cmds...)
+		case "ctrl+o":
+			if m.openMenu() {
+				return m, tea.Batch(cmds...)
+			}
+		case "right":
+			// Only open the menu when the cursor is at the end of the filter
+			// text, otherwise the cursor is moved.
+			if m.textInput.Position() == len([]rune(m.Filter())) && m.openMenu() {
+				return m, tea.Batch(cmds...)
+			}
+		case "ctrl+p":
+			cmds = append(cmds, m.TogglePin())
+
+			return m, tea.Batch(cmds...)
+		case "up", "down":
+			if m.updatePins(msgType) {
+				return m, tea.Batch(cmds...)
+			}
+		case "ctrl+y":
+			cmds = append(cmds, m.Copy())
+
+			return m, tea.Batch(cmds...)
+		case "ctrl+t":
+			m.ToggleMark()
+
+			return m, tea.Batch(cmds...)
+		case "tab":
+			m.Complete()
 		case "enter":
-			m.selectedItem = m.list.SelectedItem()
+			m.selectedItem = m.SelectedItem()
 		case "esc":
 			m.textInput.Reset()
 			m.list.ResetSelected()
@@ -85,8 +207,22 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	m.textInput, cmd = m.textInput.Update(msg)
 	cmds = append(cmds, cmd)
 
-	m.list, cmd = m.list.Update(msg)
-	cmds = append(cmds, cmd)
+	if !m.updateWrap(msg) {
+		m.list, cmd = m.list.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	// The matching items and pinned items may have changed with the filter.
+	if m.Filter() != filter {
+		cmds = append(cmds, m.setMatches())
+		m.setPinned()
+		m.setListHeight()
+	}
+
+	// Reload the command output when the filter has changed.
+	if m.Command.Reload && m.Filter() != filter {
+		cmds = append(cmds, m.RunCommand())
+	}
 
 	return m, tea.Batch(cmds...)
 }
@@ -95,16 +231,50 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 func (m Model) View() string {
 	// Set the paginator options with the current page and total pages from
 	// the list component.
+	position, total := m.pagination()
+
 	po := Paginator{
-		Position: m.list.Paginator.Page,
-		Total:    m.list.Paginator.TotalPages,
+		Position: position,
+		Total:    total,
 		Height:   m.Height,
 		Styles:   m.Paginator.Styles,
 	}
 
-	// Join the text input and list components vertically.
-	left := lipgloss.JoinVertical(lipgloss.Top, m.textInput.View(), m.list.View())
-	right := NewPaginator(po)
+	// Render the page number in place of the text input.
+	left := m.textInput.View()
+	if m.jumping {
+		left = m.jumpView()
+	}
+
+	// The list is hidden when there is no space remaining below the text
+	// input as the list component always renders at least one item.
+	if m.listHeight() > 0 {
+		lv := m.listView()
+
+		// Render the action menu over the list.
+		if m.menuOpen {
+			lv = m.menuView(lv)
+		}
+
+		// Render the pinned items above the list.
+		if ph := m.pinnedHeight(m.listHeight()); ph > 0 {
+			lv = lipgloss.JoinVertical(lipgloss.Top, m.pinnedView(ph), lv)
+		}
+
+		// Join the text input and list components vertically.
+		left = lipgloss.JoinVertical(lipgloss.Top, left, lv)
+	}
+
+	// Add the command status area below the list.
+	if m.Command.Name != "" {
+		left = lipgloss.JoinVertical(lipgloss.Top, left, m.commandView())
+	}
+
+	// The paginator is hidden when there is not enough space.
+	var right string
+	if m.showPaginator() {
+		right = NewPaginator(po)
+	}
 
 	// Join the text input and list components to the paginator.
 	return lipgloss.JoinHorizontal(lipgloss.Top, left, right)
@@ -127,16 +297,25 @@ func (m *Model) Blur() {
 	m.focus = false
 }
 
-// setModels sets the style of the components of the model.
+// setModels sets the style of the components of the model. The styles
+// are only merged and the components set when the configuration has changed.
 func (m *Model) setModels() tea.Cmd {
-	// Merge the default styles with any overrides.
-	m.List.Styles = mergeListStyles(m.List.Styles)
-	m.TextInput.Styles = mergeTextInputStyles(m.TextInput.Styles)
-	m.Paginator.Styles = mergePaginatorStyles(m.Paginator.Styles)
-
-	// Set the base state of the list and text input.
-	m.setList()
-	m.setTextInput()
+	if m.changed() {
+		// Merge the default styles with any overrides.
+		m.List.Styles = mergeListStyles(m.List.Styles)
+		m.TextInput.Styles = mergeTextInputStyles(m.TextInput.Styles)
+		m.Paginator.Styles = mergePaginatorStyles(m.Paginator.Styles)
+		m.Menu.Styles = mergeMenuStyles(m.Menu.Styles)
+		m.Pins.Styles = mergePinStyles(m.Pins.Styles, m.List.Styles)
+
+		// Set the base state of the list and text input.
+		m.setList()
+		m.setTextInput()
+
+		c := m.config()
+		m.applied = &c
+		m.restyle = false
+	}
 
 	// Match the text input focus with the focus state of the model.
 	switch {
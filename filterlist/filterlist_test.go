@@ -1,11 +1,18 @@
 package filterlist_test
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/mikelorant/teaset/filterlist"
 	"github.com/mikelorant/teaset/uitest"
 
+	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hexops/autogold/v2"
@@ -28,6 +35,51 @@ func (i MockItem) FilterValue() string {
 	return "Test"
 }
 
+type MockKeywordItem struct {
+	title       string
+	description string
+	keywords    []string
+}
+
+func (i MockKeywordItem) Title() string {
+	return i.title
+}
+
+func (i MockKeywordItem) Description() string {
+	return i.description
+}
+
+func (i MockKeywordItem) FilterValue() string {
+	return ""
+}
+
+func (i MockKeywordItem) Keywords() []string {
+	return i.keywords
+}
+
+type MockPinStore struct {
+	pins  []string
+	saved *[]string
+}
+
+func (s MockPinStore) Load() ([]string, error) {
+	return s.pins, nil
+}
+
+func (s MockPinStore) Save(pins []string) error {
+	*s.saved = pins
+
+	return nil
+}
+
+type ErrWriter struct{}
+
+var errWrite = errors.New("write error")
+
+func (w ErrWriter) Write(_ []byte) (int, error) {
+	return 0, errWrite
+}
+
 func TestModel(t *testing.T) {
 	t.Parallel()
 
@@ -100,6 +152,30 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		"height_1": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.AutoSize = true
+					m, _ = m.Update(tea.WindowSizeMsg{Width: 4, Height: 1})
+
+					return m
+				},
+			},
+		},
+		"height_2": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.AutoSize = true
+					m, _ = m.Update(tea.WindowSizeMsg{Width: 20, Height: 2})
+
+					return m
+				},
+			},
+		},
 		"prompt_text": {
 			args: args{
 				model: func(m filterlist.Model) filterlist.Model {
@@ -312,60 +388,1279 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
-		"override": {
+		"command": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					m.Command.Name = "sh"
+					m.Command.Args = []string{"-c", "printf 'one\\ntwo\\nthree\\n'"}
+
+					return runCommand(m, m.RunCommand())
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.False(t, m.CommandRunning())
+					assert.Equal(t, "one", m.SelectedItem().(filterlist.CommandItem).Title())
+				},
+			},
+		},
+		"command_error": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					m.Command.Name = "sh"
+					m.Command.Args = []string{"-c", "echo partial; echo failure >&2; exit 3"}
+
+					return runCommand(m, m.RunCommand())
+				},
+			},
+		},
+		"command_stop": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					m.Command.Name = "yes"
+					cmd := m.RunCommand()
+
+					assert.True(t, m.CommandRunning())
+
+					m.StopCommand()
+
+					assert.False(t, m.CommandRunning())
+
+					// The exit of the command is received once cancelled.
+					return runCommand(m, cmd)
+				},
+			},
+		},
+		"command_long_line": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					m.Command.Name = "sh"
+					m.Command.Args = []string{"-c", "head -c 70000 /dev/zero | tr '\\0' a; echo; seq 1 3"}
+
+					return runCommand(m, m.RunCommand())
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.False(t, m.CommandRunning())
+					assert.Len(t, m.Items(), 4)
+					assert.Len(t, m.Items()[0].FilterValue(), 70000)
+				},
+			},
+		},
+		"command_reload": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					var cmd tea.Cmd
+
+					m.Command.Name = "sh"
+					m.Command.Args = []string{"-c", "echo \"$1\"; echo \"$1$1\"", "sh", filterlist.CommandQuery}
+					m.Command.Reload = true
+					m.Focus()
+					m = sendString(m, "a")
+					m, cmd = m.Update(uitest.KeyPress('b'))
+
+					return runCommand(m, cmd)
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "ab", m.SelectedItem().(filterlist.CommandItem).Title())
+				},
+			},
+		},
+		"menu": {
 			args: args{
 				model: func(m filterlist.Model) filterlist.Model {
 					items := testItems()
 					m.SetItems(filterlist.ToItems(items))
-					m.TextInput.Styles.PromptMark = lipgloss.NewStyle().MarginLeft(4)
-					m.List.Styles.Item = lipgloss.NewStyle().MarginLeft(4)
-					m.List.Styles.ItemSelected = lipgloss.NewStyle().MarginLeft(4)
+					m.Menu.Actions = []string{"open", "copy", "delete"}
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
 
 					return m
 				},
 			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.True(t, m.MenuOpen())
+					assert.Equal(t, "item 1234", m.SelectedItem().(MockItem).Title())
+				},
+			},
 		},
-	}
+		"menu_action": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					var cmd tea.Cmd
 
-	for name, tt := range tests {
-		tt := tt
-		name := name
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Menu.Actions = []string{"open", "copy", "delete"}
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 
-		t.Run(name, func(t *testing.T) {
-			t.Parallel()
+					assert.Contains(t, cmdMsgs(cmd), filterlist.ActionMsg{
+						Item:   MockItem{title: "item 2345"},
+						Action: "copy",
+					})
 
-			t.Run(name, func(t *testing.T) {
-				m := filterlist.New()
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.False(t, m.MenuOpen())
+				},
+			},
+		},
+		"menu_scroll": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Menu.Actions = []string{"a", "b", "c", "d", "e", "f"}
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
 
-				if tt.args.model != nil {
-					m = tt.args.model(m)
-				}
+					for i := 0; i < 6; i++ {
+						m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					}
 
-				m, _ = m.Update(nil)
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 
-				if tt.want.model != nil {
-					tt.want.model(m)
-				}
+					assert.Contains(t, cmdMsgs(cmd), filterlist.ActionMsg{
+						Item:   MockItem{title: "item 1234"},
+						Action: "f",
+					})
+				},
+			},
+		},
+		"menu_above": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Menu.Actions = []string{"open", "copy"}
+					m.Focus()
+					m.Select(3)
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
 
-				v := uitest.StripString(m.View())
-				autogold.ExpectFile(t, autogold.Raw(v), autogold.Name(name))
-			})
-		})
-	}
-}
+					return m
+				},
+			},
+		},
+		"menu_escape": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Menu.Actions = []string{"open", "copy", "delete"}
+					m.Focus()
+					m = sendString(m, "ab")
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
 
-func testItems() []MockItem {
-	return []MockItem{
-		{title: "item 1234"},
-		{title: "item 2345"},
-		{title: "item 3456"},
-		{title: "item 4567"},
-		{title: "item 5678"},
-		{title: "item 6789"},
-		{title: "item 7890"},
-		{title: "item 8901"},
-		{title: "item 9012"},
-	}
+					assert.False(t, m.MenuOpen())
+
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+
+					assert.True(t, m.MenuOpen())
+
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.False(t, m.MenuOpen())
+					assert.Equal(t, "ab", m.Filter())
+				},
+			},
+		},
+		"pins": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.Height = 8
+					m.SetItems(filterlist.ToItems(items))
+					m.Pins.Store = MockPinStore{pins: []string{"item 5678", "item 3456", "missing"}}
+					m.LoadPins()
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Len(t, m.Pinned(), 2)
+				},
+			},
+		},
+		"pins_before_items": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.Height = 6
+					m.Pins.Store = MockPinStore{pins: []string{"item 5678", "item 3456"}}
+					m.LoadPins()
+					m, _ = m.Update(nil)
+					m.SetItems(filterlist.ToItems(items))
+					m, _ = m.Update(nil)
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, 6, lipgloss.Height(m.View()))
+				},
+			},
+		},
+		"pin_toggle": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					var (
+						cmd   tea.Cmd
+						saved []string
+					)
+
+					items := testItems()
+					m.Height = 8
+					m.SetItems(filterlist.ToItems(items))
+					m.Pins.Store = MockPinStore{saved: &saved}
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+					cmdMsgs(cmd)
+
+					assert.Equal(t, []string{"item 2345"}, saved)
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.True(t, m.IsPinned(MockItem{title: "item 2345"}))
+				},
+			},
+		},
+		"pin_select": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.Height = 8
+					m.SetItems(filterlist.ToItems(items))
+					m.Pins.Store = MockPinStore{pins: []string{"item 5678", "item 3456"}}
+					m.LoadPins()
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "item 5678", m.SelectedItem().(MockItem).Title())
+				},
+			},
+		},
+		"pin_unpin": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.Height = 8
+					m.SetItems(filterlist.ToItems(items))
+					m.Pins.Store = MockPinStore{pins: []string{"item 5678", "item 3456"}, saved: &[]string{}}
+					m.LoadPins()
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Len(t, m.Pinned(), 1)
+					assert.Equal(t, "item 5678", m.SelectedItem().(MockItem).Title())
+				},
+			},
+		},
+		"pin_filter": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.Height = 8
+					m.Weights = filterlist.Weights{Title: 1}
+					m.SetItems(filterlist.ToItems(items))
+					m.Pins.Store = MockPinStore{pins: []string{"item 5678", "item 1234"}}
+					m.LoadPins()
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+					m = sendString(m, "56")
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, filterlist.ToItems([]MockItem{{title: "item 5678"}}), m.Pinned())
+				},
+			},
+		},
+		"pin_filter_unweighted": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.Height = 8
+					m.SetItems(filterlist.ToItems(items))
+					m.Pins.Store = MockPinStore{pins: []string{"item 5678"}}
+					m.LoadPins()
+					m.Focus()
+					m = sendString(m, "xyz")
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Len(t, m.Pinned(), 1)
+				},
+			},
+		},
+		"pin_select_list": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.Height = 8
+					m.SetItems(filterlist.ToItems(items))
+					m.Pins.Store = MockPinStore{pins: []string{"item 5678"}}
+					m.LoadPins()
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+
+					assert.Equal(t, "item 5678", m.SelectedItem().(MockItem).Title())
+
+					m.Select(2)
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "item 3456", m.SelectedItem().(MockItem).Title())
+				},
+			},
+		},
+		"wrap": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testLongItems()
+					m.Width = 30
+					m.Height = 8
+					m.List.Wrap = true
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "https://example.com/a/very/long/path/to/a/resource", m.SelectedItem().(MockItem).Title())
+				},
+			},
+		},
+		"wrap_pagedown": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testLongItems()
+					m.Width = 30
+					m.Height = 8
+					m.List.Wrap = true
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "Short", m.SelectedItem().(MockItem).Title())
+				},
+			},
+		},
+		"wrap_pageup": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testLongItems()
+					m.Width = 30
+					m.Height = 8
+					m.List.Wrap = true
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyPgUp})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "Fix race condition in the file watcher when the configuration is reloaded", m.SelectedItem().(MockItem).Title())
+				},
+			},
+		},
+		"complete": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testPathItems()
+					m.Width = 30
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m = sendString(m, "S")
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "src/", m.Filter())
+				},
+			},
+		},
+		"weights": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testKeywordItems()
+					m.Weights = filterlist.Weights{Title: 3, Description: 2, Keywords: 1}
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m = sendString(m, "apple")
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "Apple", m.SelectedItem().(MockKeywordItem).Title())
+					assert.Len(t, m.Items(), 4)
+				},
+			},
+		},
+		"weights_field": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testKeywordItems()
+					m.Weights = filterlist.Weights{Keywords: 1}
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m = sendString(m, "apple")
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "Crumble", m.SelectedItem().(MockKeywordItem).Title())
+				},
+			},
+		},
+		"weights_reset": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testKeywordItems()
+					m.Weights = filterlist.Weights{Title: 1}
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m = sendString(m, "apple")
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "Pie", m.SelectedItem().(MockKeywordItem).Title())
+				},
+			},
+		},
+		"filter_slash": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testPathItems()
+					m.Width = 30
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m = sendString(m, "src/")
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "src/", m.Filter())
+					assert.Equal(t, "src/cmd/root.go", m.SelectedItem().(MockItem).Title())
+				},
+			},
+		},
+		"complete_common": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testPathItems()
+					m.Width = 30
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m = sendString(m, "src/c")
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "src/cmd/r", m.Filter())
+				},
+			},
+		},
+		"complete_highlighted": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testPathItems()
+					m.Width = 30
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m = sendString(m, "src/cmd/r")
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "src/cmd/root.go", m.Filter())
+				},
+			},
+		},
+		"window_size": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.AutoSize = true
+					m.MaxWidth = 30
+					m.MaxHeight = 6
+					m, _ = m.Update(tea.WindowSizeMsg{Width: 100, Height: 50})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, 30, m.Width)
+					assert.Equal(t, 6, m.Height)
+				},
+			},
+		},
+		"window_size_disabled": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m, _ = m.Update(tea.WindowSizeMsg{Width: 100, Height: 50})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, 20, m.Width)
+					assert.Equal(t, 5, m.Height)
+				},
+			},
+		},
+		"window_size_compact": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.AutoSize = true
+					m, _ = m.Update(tea.WindowSizeMsg{Width: 11, Height: 3})
+
+					return m
+				},
+			},
+		},
+		"window_size_minimum": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.AutoSize = true
+					m.MinWidth = 12
+					m.MinHeight = 2
+					m, _ = m.Update(tea.WindowSizeMsg{Width: 1, Height: 1})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, 12, m.Width)
+					assert.Equal(t, 2, m.Height)
+				},
+			},
+		},
+		"window_size_tiny": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.SetSize(1, 1)
+
+					return m
+				},
+			},
+		},
+		"override_after_update": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m, _ = m.Update(nil)
+					m.TextInput.PromptText = "test"
+					m.List.Styles.Item = m.List.Styles.Item.MarginLeft(4)
+					m.List.Styles.ItemIndicator = ">"
+					m.Restyle()
+
+					return m
+				},
+			},
+		},
+		"mark": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, filterlist.ToItems([]MockItem{
+						{title: "item 1234"},
+						{title: "item 3456"},
+					}), m.Marked())
+				},
+			},
+		},
+		"unmark": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Empty(t, m.Marked())
+				},
+			},
+		},
+		"mark_copy": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+
+					prev := m
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+
+					assert.Len(t, prev.Marked(), 1)
+					assert.NotContains(t, prev.View(), "✓ item 2345")
+
+					return prev
+				},
+			},
+		},
+		"state": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m = sendString(m, "test")
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+
+					want := filterlist.State{
+						Query: "test",
+						Item:  "item 6789",
+						Page:  1,
+						Marks: []string{"item 1234", "item 6789"},
+						Focus: true,
+					}
+					assert.Equal(t, want, m.State())
+
+					data, err := json.Marshal(m.State())
+					assert.NoError(t, err)
+
+					var state filterlist.State
+					assert.NoError(t, json.Unmarshal(data, &state))
+
+					r := filterlist.New()
+					r.SetItems(filterlist.ToItems(items))
+					r.Restore(state)
+
+					assert.Equal(t, want, r.State())
+
+					return r
+				},
+			},
+		},
+		"restore_page": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Restore(filterlist.State{
+						Item: "missing",
+						Page: 2,
+					})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "item 9012", m.SelectedItem().(MockItem).Title())
+					assert.False(t, m.Focused())
+				},
+			},
+		},
+		"end": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnd})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "item 9012", m.SelectedItem().(MockItem).Title())
+				},
+			},
+		},
+		"home": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnd})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyHome})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "item 1234", m.SelectedItem().(MockItem).Title())
+				},
+			},
+		},
+		"half_page_down": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftDown})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "item 7890", m.SelectedItem().(MockItem).Title())
+				},
+			},
+		},
+		"half_page_up": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnd})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftUp})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "item 7890", m.SelectedItem().(MockItem).Title())
+				},
+			},
+		},
+		"jump": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+					m, _ = m.Update(uitest.KeyPress('2'))
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "item 5678", m.SelectedItem().(MockItem).Title())
+					assert.False(t, m.Jumping())
+					assert.Empty(t, m.Filter())
+				},
+			},
+		},
+		"jump_entry": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+					m, _ = m.Update(uitest.KeyPress('1'))
+					m, _ = m.Update(uitest.KeyPress('2'))
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.True(t, m.Jumping())
+				},
+			},
+		},
+		"jump_invalid": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+					m, _ = m.Update(uitest.KeyPress('9'))
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "item 1234", m.SelectedItem().(MockItem).Title())
+				},
+			},
+		},
+		"jump_cancel": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+					m, _ = m.Update(uitest.KeyPress('3'))
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m filterlist.Model) {
+					assert.Equal(t, "item 1234", m.SelectedItem().(MockItem).Title())
+					assert.False(t, m.Jumping())
+				},
+			},
+		},
+		"copy": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					var (
+						cmd tea.Cmd
+						buf bytes.Buffer
+					)
+
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Clipboard.Writer = &buf
+					m.Clipboard.Passthrough = filterlist.PassthroughNone
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+
+					assert.Contains(t, cmdMsgs(cmd), filterlist.CopyMsg{Text: "item 2345"})
+					assert.Equal(t, "\x1b]52;c;"+base64.StdEncoding.EncodeToString([]byte("item 2345"))+"\a", buf.String())
+
+					return m
+				},
+			},
+		},
+		"copy_tmux": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					var (
+						cmd tea.Cmd
+						buf bytes.Buffer
+					)
+
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Clipboard.Writer = &buf
+					m.Clipboard.Passthrough = filterlist.PassthroughTmux
+					m.Focus()
+					m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+
+					assert.Contains(t, cmdMsgs(cmd), filterlist.CopyMsg{Text: "item 1234"})
+					assert.Equal(t, "\x1bPtmux;\x1b\x1b]52;c;"+base64.StdEncoding.EncodeToString([]byte("item 1234"))+"\a\x1b\\", buf.String())
+
+					return m
+				},
+			},
+		},
+		"copy_marked": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					var (
+						cmd tea.Cmd
+						buf bytes.Buffer
+					)
+
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Clipboard.Writer = &buf
+					m.Clipboard.Passthrough = filterlist.PassthroughNone
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+					m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+
+					text := "item 1234\nitem 3456"
+
+					assert.Contains(t, cmdMsgs(cmd), filterlist.CopyMsg{Text: text})
+					assert.Equal(t, "\x1b]52;c;"+base64.StdEncoding.EncodeToString([]byte(text))+"\a", buf.String())
+
+					return m
+				},
+			},
+		},
+		"copy_fallback": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					var (
+						cmd    tea.Cmd
+						copied string
+					)
+
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Clipboard.Writer = ErrWriter{}
+					m.Clipboard.Fallback = func(s string) error {
+						copied = s
+
+						return nil
+					}
+					m.Focus()
+					m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+
+					assert.Contains(t, cmdMsgs(cmd), filterlist.CopyMsg{Text: "item 1234"})
+					assert.Equal(t, "item 1234", copied)
+
+					return m
+				},
+			},
+		},
+		"copy_error": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					var cmd tea.Cmd
+
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.Clipboard.Writer = ErrWriter{}
+					m.Focus()
+					m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+
+					assert.Contains(t, cmdMsgs(cmd), filterlist.CopyMsg{Text: "item 1234", Err: errWrite})
+
+					return m
+				},
+			},
+		},
+		"override": {
+			args: args{
+				model: func(m filterlist.Model) filterlist.Model {
+					items := testItems()
+					m.SetItems(filterlist.ToItems(items))
+					m.TextInput.Styles.PromptMark = lipgloss.NewStyle().MarginLeft(4)
+					m.List.Styles.Item = lipgloss.NewStyle().MarginLeft(4)
+					m.List.Styles.ItemSelected = lipgloss.NewStyle().MarginLeft(4)
+
+					return m
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		name := name
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run(name, func(t *testing.T) {
+				m := filterlist.New()
+
+				if tt.args.model != nil {
+					m = tt.args.model(m)
+				}
+
+				m, _ = m.Update(nil)
+
+				if tt.want.model != nil {
+					tt.want.model(m)
+				}
+
+				v := uitest.StripString(m.View())
+				autogold.ExpectFile(t, autogold.Raw(v), autogold.Name(name))
+			})
+		})
+	}
+}
+
+func BenchmarkUpdate(b *testing.B) {
+	typing := []tea.Msg{
+		uitest.KeyPress('a'),
+		tea.KeyMsg{Type: tea.KeyBackspace},
+	}
+
+	benchmarks := map[string]struct {
+		items int
+		pins  []string
+		wrap  bool
+		msgs  []tea.Msg
+	}{
+		"key_down":              {items: 10, msgs: []tea.Msg{tea.KeyMsg{Type: tea.KeyDown}}},
+		"key_down_large":        {items: 100000, msgs: []tea.Msg{tea.KeyMsg{Type: tea.KeyDown}}},
+		"key_down_pinned_large": {items: 100000, pins: []string{"item 5"}, msgs: []tea.Msg{tea.KeyMsg{Type: tea.KeyDown}}},
+		"key_down_wrap_large":   {items: 100000, wrap: true, msgs: []tea.Msg{tea.KeyMsg{Type: tea.KeyDown}}},
+		"typing":                {items: 10, msgs: typing},
+		"typing_large":          {items: 100000, msgs: typing},
+		"mouse_move_large":      {items: 100000, msgs: []tea.Msg{tea.MouseMsg{Type: tea.MouseMotion}}},
+	}
+
+	for name, bb := range benchmarks {
+		bb := bb
+
+		b.Run(name, func(b *testing.B) {
+			m := filterlist.New()
+			m.Pins.Store = MockPinStore{pins: bb.pins}
+			m.List.Wrap = bb.wrap
+			m.SetItems(benchItems(bb.items))
+			m.LoadPins()
+			m.Focus()
+			m, _ = m.Update(nil)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				m, _ = m.Update(bb.msgs[i%len(bb.msgs)])
+			}
+		})
+	}
+}
+
+func BenchmarkView(b *testing.B) {
+	benchmarks := map[string]struct {
+		items int
+		wrap  bool
+	}{
+		"small":      {items: 10},
+		"large":      {items: 100000},
+		"wrap_large": {items: 100000, wrap: true},
+	}
+
+	for name, bb := range benchmarks {
+		bb := bb
+
+		b.Run(name, func(b *testing.B) {
+			m := filterlist.New()
+			m.List.Wrap = bb.wrap
+			m.SetItems(benchItems(bb.items))
+			m.Focus()
+			m, _ = m.Update(nil)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_ = m.View()
+			}
+		})
+	}
+}
+
+func benchItems(n int) []list.Item {
+	items := make([]list.Item, n)
+	for idx := range items {
+		items[idx] = MockItem{title: fmt.Sprintf("item %d", idx)}
+	}
+
+	return items
+}
+
+func testItems() []MockItem {
+	return []MockItem{
+		{title: "item 1234"},
+		{title: "item 2345"},
+		{title: "item 3456"},
+		{title: "item 4567"},
+		{title: "item 5678"},
+		{title: "item 6789"},
+		{title: "item 7890"},
+		{title: "item 8901"},
+		{title: "item 9012"},
+	}
+}
+
+func testLongItems() []MockItem {
+	return []MockItem{
+		{title: "Fix race condition in the file watcher when the configuration is reloaded"},
+		{title: "https://example.com/a/very/long/path/to/a/resource"},
+		{title: "Short"},
+		{title: "Add support for custom key bindings in the filter list"},
+		{title: "Tidy"},
+	}
+}
+
+func testPathItems() []MockItem {
+	return []MockItem{
+		{title: "README.md"},
+		{title: "src/cmd/root.go"},
+		{title: "src/cmd/run.go"},
+		{title: "src/main.go"},
+	}
+}
+
+// runCommand runs the command and any commands returned until
+// the command has exited.
+func testKeywordItems() []MockKeywordItem {
+	return []MockKeywordItem{
+		{title: "Pie", description: "Baked apple pastry"},
+		{title: "Crumble", description: "Baked fruit", keywords: []string{"apple", "oat"}},
+		{title: "Apple", description: "Fruit"},
+		{title: "Bread", description: "Baked loaf"},
+	}
+}
+
+func runCommand(m filterlist.Model, cmd tea.Cmd) filterlist.Model {
+	if cmd == nil {
+		return m
+	}
+
+	ch := make(chan tea.Msg, 1)
+	go func() {
+		ch <- cmd()
+	}()
+
+	var msg tea.Msg
+
+	select {
+	case msg = <-ch:
+	case <-time.After(5 * time.Second):
+		return m
+	}
+
+	switch msg := msg.(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			m = runCommand(m, c)
+		}
+	case filterlist.CommandOutputMsg, filterlist.CommandExitMsg:
+		m, cmd = m.Update(msg)
+		m = runCommand(m, cmd)
+	}
+
+	return m
+}
+
+// cmdMsgs runs the command and returns all messages including those
+// of batched commands.
+func cmdMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+
+	msg := cmd()
+
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+
+	var msgs []tea.Msg
+	for _, c := range batch {
+		msgs = append(msgs, cmdMsgs(c)...)
+	}
+
+	return msgs
 }
 
 //nolint:ireturn
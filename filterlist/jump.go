@@ -0,0 +1,124 @@
+package filterlist
+
+import (
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	jumpPromptText = "Page:"
+)
+
+// Jumping returns whether a page number is being entered.
+func (m Model) Jumping() bool {
+	return m.jumping
+}
+
+// SelectFirst highlights the first item.
+func (m *Model) SelectFirst() {
+	m.pinSelected = false
+	m.list.Select(0)
+}
+
+// SelectLast highlights the last item.
+func (m *Model) SelectLast() {
+	m.pinSelected = false
+
+	if n := len(m.list.VisibleItems()); n > 0 {
+		m.list.Select(n - 1)
+	}
+}
+
+// HalfPageDown moves the highlighted item down by half a page.
+func (m *Model) HalfPageDown() {
+	n := len(m.list.VisibleItems())
+	if n == 0 {
+		return
+	}
+
+	idx := m.list.Index() + m.halfPage()
+	if idx > n-1 {
+		idx = n - 1
+	}
+
+	m.pinSelected = false
+	m.list.Select(idx)
+}
+
+// HalfPageUp moves the highlighted item up by half a page.
+func (m *Model) HalfPageUp() {
+	idx := m.list.Index() - m.halfPage()
+	if idx < 0 {
+		idx = 0
+	}
+
+	m.pinSelected = false
+	m.list.Select(idx)
+}
+
+// updateJump handles keyboard input while a page number is being entered.
+// The page is selected when enter is pressed and any other key cancels.
+func (m *Model) updateJump(msg tea.Msg) {
+	msgType, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return
+	}
+
+	switch msgType.String() {
+	case "0", "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		m.jump += msgType.String()
+	case "backspace":
+		if m.jump != "" {
+			m.jump = m.jump[:len(m.jump)-1]
+		}
+	case "enter":
+		m.jumping = false
+
+		if page, err := strconv.Atoi(m.jump); err == nil {
+			m.pinSelected = false
+			m.selectPage(page - 1)
+		}
+	default:
+		m.jumping = false
+	}
+}
+
+// jumpView renders the page number being entered in place of the text input.
+// The width matches the text input so the paginator is not moved.
+func (m Model) jumpView() string {
+	pm := m.TextInput.Styles.PromptMark.Render(m.TextInput.PromptMark)
+	pt := m.TextInput.Styles.PromptText.Render(jumpPromptText)
+	jv := lipgloss.JoinHorizontal(lipgloss.Top, pm, pt, m.TextInput.Styles.Text.Render(m.jump))
+
+	return lipgloss.NewStyle().Width(lipgloss.Width(m.textInput.View())).Render(jv)
+}
+
+// halfPage is half the number of items on the current page.
+func (m Model) halfPage() int {
+	perPage := m.list.Paginator.PerPage
+
+	if m.List.Wrap {
+		_, start, end := m.wrapPage(m.list.Index())
+		perPage = end - start
+	}
+
+	return max(perPage/2, 1)
+}
+
+// selectPage highlights the first item of the page.
+func (m *Model) selectPage(page int) {
+	if m.List.Wrap {
+		pages := m.wrapPages()
+		if page >= 0 && page < len(pages) {
+			m.list.Select(pages[page])
+		}
+
+		return
+	}
+
+	if page >= 0 && page < m.list.Paginator.TotalPages {
+		m.list.Select(page * m.list.Paginator.PerPage)
+	}
+}
@@ -10,6 +10,10 @@ import (
 type List struct {
 	Width  int
 	Height int
+
+	// Wrap long item titles onto multiple lines instead of truncating.
+	Wrap bool
+
 	Styles ListStyles
 }
 
@@ -29,6 +33,12 @@ type ListStyles struct {
 
 	// Style of empty list.
 	NoItems lipgloss.Style
+
+	// The marked item.
+	ItemMarked lipgloss.Style
+
+	// The marked item indicator character.
+	MarkIndicator string
 }
 
 const (
@@ -43,6 +53,9 @@ func NewList(lo List) list.Model {
 	l.SetShowStatusBar(false)
 	l.SetShowTitle(false)
 	l.SetShowFilter(false)
+	// The filter text is typed into the text input so the slash key must
+	// not start filtering the list.
+	l.SetFilteringEnabled(false)
 
 	return l
 }
@@ -67,31 +80,92 @@ func NewDefaultItemStyles(styles ListStyles) list.DefaultItemStyles {
 	return s
 }
 
-// SetItems set the items in the list.
+// SetItems set the items in the list. Only the items matching the filter are
+// shown when weights are set.
 func (m *Model) SetItems(is []list.Item) tea.Cmd {
-	return m.list.SetItems(is)
+	m.items = is
+	m.pinSelected = false
+	cmd := m.list.SetItems(m.matchItems(is))
+	// The pinned items found may have changed the space for the list.
+	m.setPinned()
+	m.setListHeight()
+
+	return cmd
 }
 
 // Selected item selects the current item.
 //
 //nolint:ireturn
 func (m Model) SelectedItem() list.Item {
+	if pinned := m.Pinned(); m.pinSelected && m.pinIndex < len(pinned) {
+		return pinned[m.pinIndex]
+	}
+
 	return m.list.SelectedItem()
 }
 
 // Select moves the selected item to the index specified.
 func (m *Model) Select(i int) {
+	m.pinSelected = false
 	m.list.Select(i)
 }
 
 // setList sets the list dimension and styles.
 func (m *Model) setList() {
-	// Remove selected indicator (1) + selected padding (1) +
-	// margin of paginator (1) and paginator (1) = 4
-	m.list.SetWidth(m.Width - 4)
+	m.list.SetWidth(m.contentWidth())
+	m.setListHeight()
+	m.list.SetDelegate(newMarkDelegate(m.List.Styles, m.marks))
+}
+
+// setListHeight sets the list height to the remaining space after the
+// pinned items.
+func (m *Model) setListHeight() {
+	height := m.listHeight()
+	m.list.SetHeight(height - m.pinnedHeight(height))
+	m.setWrap()
+}
+
+// listHeight is the height available for the list and pinned items.
+func (m Model) listHeight() int {
 	// Text input uses the first line.
-	m.list.SetHeight(m.Height - 1)
-	m.list.SetDelegate(NewDelegate(m.List.Styles))
+	height := m.Height - 1
+	// Command status uses the last line.
+	if m.Command.Name != "" {
+		height--
+	}
+
+	return max(height, 0)
+}
+
+// listView renders the list. The selected item is not highlighted while a
+// pinned item is selected.
+func (m Model) listView() string {
+	if m.List.Wrap {
+		return m.wrapView()
+	}
+
+	if !m.pinSelected {
+		return m.list.View()
+	}
+
+	ls := m.List.Styles
+	ls.ItemSelected = ls.Item
+
+	l := m.list
+	l.SetDelegate(newMarkDelegate(ls, m.marks))
+
+	return l.View()
+}
+
+// pagination returns the current page and total pages of the list.
+func (m Model) pagination() (int, int) {
+	if !m.List.Wrap {
+		return m.list.Paginator.Page, m.list.Paginator.TotalPages
+	}
+
+	page, _, _ := m.wrapPage(m.list.Index())
+
+	return page, len(m.wrapPages())
 }
 
 // ToItems casts the list of items so they ca be used with
@@ -110,8 +184,11 @@ func ToItems[T list.Item](v []T) []list.Item {
 func mergeListStyles(ls ListStyles) ListStyles {
 	bs := lipgloss.Border{Left: ls.ItemIndicator}
 
+	ms := lipgloss.Border{Left: ls.MarkIndicator}
+
 	ls.Item = ls.Item.PaddingLeft(2)
 	ls.ItemSelected = ls.ItemSelected.BorderStyle(bs).BorderLeft(true).PaddingLeft(1)
+	ls.ItemMarked = ls.ItemMarked.BorderStyle(ms).BorderLeft(true).PaddingLeft(1)
 
 	return ls
 }
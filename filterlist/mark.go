@@ -0,0 +1,112 @@
+package filterlist
+
+import (
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// marks is the set of marked item identifiers. The set is shared with the
+// list delegate and copies of the model so it is never modified. A new set
+// is created whenever the marks change.
+type marks map[string]struct{}
+
+// markDelegate is a list delegate that renders marked items with the
+// marked style.
+type markDelegate struct {
+	list.DefaultDelegate
+
+	marks  marks
+	marked list.DefaultDelegate
+}
+
+const (
+	defaultMarkIndicator = "✓"
+)
+
+// newMarkDelegate creates a new list delegate that renders marked items.
+func newMarkDelegate(styles ListStyles, ms marks) markDelegate {
+	md := styles
+	md.Item = styles.ItemMarked
+
+	return markDelegate{
+		DefaultDelegate: NewDelegate(styles),
+		marks:           ms,
+		marked:          NewDelegate(md),
+	}
+}
+
+// Render renders the item with the marked style if it is marked.
+func (d markDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	if _, ok := d.marks[itemID(item)]; ok {
+		d.marked.Render(w, m, index, item)
+
+		return
+	}
+
+	d.DefaultDelegate.Render(w, m, index, item)
+}
+
+// ToggleMark marks or unmarks the highlighted item.
+func (m *Model) ToggleMark() {
+	item := m.SelectedItem()
+	if item == nil {
+		return
+	}
+
+	id := itemID(item)
+
+	ms := make(marks, len(m.marks)+1)
+	for i := range m.marks {
+		ms[i] = struct{}{}
+	}
+
+	switch _, ok := ms[id]; {
+	case ok:
+		delete(ms, id)
+	default:
+		ms[id] = struct{}{}
+	}
+
+	m.setMarkSet(ms)
+}
+
+// IsMarked returns whether the item is marked.
+func (m Model) IsMarked(item list.Item) bool {
+	_, ok := m.marks[itemID(item)]
+
+	return ok
+}
+
+// Marked returns the marked items in the order of the list.
+func (m Model) Marked() []list.Item {
+	if len(m.marks) == 0 {
+		return nil
+	}
+
+	var items []list.Item
+
+	for _, i := range m.items {
+		if m.IsMarked(i) {
+			items = append(items, i)
+		}
+	}
+
+	return items
+}
+
+// setMarks replaces the marked items.
+func (m *Model) setMarks(ids []string) {
+	ms := make(marks, len(ids))
+	for _, id := range ids {
+		ms[id] = struct{}{}
+	}
+
+	m.setMarkSet(ms)
+}
+
+// setMarkSet replaces the set of marks and the list delegate rendering them.
+func (m *Model) setMarkSet(ms marks) {
+	m.marks = ms
+	m.list.SetDelegate(newMarkDelegate(m.List.Styles, m.marks))
+}
@@ -0,0 +1,173 @@
+package filterlist
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Menu is the action menu model. The menu is opened for the highlighted
+// item and rendered over the list.
+type Menu struct {
+	// Actions that can be applied to an item.
+	Actions []string
+
+	Styles MenuStyles
+}
+
+// MenuStyles is the styling of the action menu.
+type MenuStyles struct {
+	// Action style.
+	Action lipgloss.Style
+
+	// The selected action.
+	ActionSelected lipgloss.Style
+
+	// The selected action indicator character.
+	ActionIndicator string
+}
+
+// ActionMsg is sent when an action has been chosen for an item.
+type ActionMsg struct {
+	Item   list.Item
+	Action string
+}
+
+const (
+	defaultActionIndicator = "▸"
+
+	// Indent of the menu to align with the item text.
+	menuIndent = 2
+)
+
+// MenuOpen returns whether the action menu is open.
+func (m Model) MenuOpen() bool {
+	return m.menuOpen
+}
+
+// openMenu opens the action menu if there are actions and an item
+// is highlighted.
+func (m *Model) openMenu() bool {
+	if len(m.Menu.Actions) == 0 || m.SelectedItem() == nil {
+		return false
+	}
+
+	m.menuOpen = true
+	m.menuIndex = 0
+
+	return true
+}
+
+// updateMenu handles keyboard input while the action menu is open.
+func (m *Model) updateMenu(msg tea.Msg) tea.Cmd {
+	msgType, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+
+	switch msgType.String() {
+	case "down":
+		if m.menuIndex < len(m.Menu.Actions)-1 {
+			m.menuIndex++
+		}
+	case "up":
+		if m.menuIndex > 0 {
+			m.menuIndex--
+		}
+	case "esc", "left", "ctrl+o":
+		m.menuOpen = false
+	case "enter":
+		m.menuOpen = false
+
+		am := ActionMsg{
+			Item:   m.SelectedItem(),
+			Action: m.Menu.Actions[m.menuIndex],
+		}
+
+		return func() tea.Msg {
+			return am
+		}
+	}
+
+	return nil
+}
+
+// menuView renders the action menu over the list. The menu is placed below
+// the highlighted item unless there is more space above it. When there are
+// more actions than space the menu scrolls to keep the selected action
+// visible.
+func (m Model) menuView(lv string) string {
+	lines := strings.Split(lv, "\n")
+	top, bottom := m.menuItemRows()
+
+	above := top
+	below := len(lines) - bottom - 1
+	size := len(m.Menu.Actions)
+
+	var row int
+
+	switch {
+	case size <= below:
+		row = bottom + 1
+	case size <= above:
+		row = top - size
+	case below >= above && below > 0:
+		size = below
+		row = bottom + 1
+	case above > 0:
+		size = above
+	// Without any space the menu is placed over the item.
+	default:
+		size = min(size, len(lines))
+		row = max(top, 0)
+	}
+
+	offset := max(m.menuIndex-size+1, 0)
+	actions := m.Menu.Actions[offset : offset+size]
+
+	indent := strings.Repeat(" ", menuIndent)
+
+	for idx, a := range actions {
+		style := m.Menu.Styles.Action
+		if offset+idx == m.menuIndex {
+			style = m.Menu.Styles.ActionSelected
+		}
+
+		lines[row+idx] = indent + style.Render(a)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// menuItemRows returns the first and last line of the highlighted item in
+// the list. A selected pinned item is above the list.
+func (m Model) menuItemRows() (int, int) {
+	switch {
+	case m.pinSelected:
+		return -1, -1
+	case m.List.Wrap:
+		bottom := m.wrapCursor()
+		height := 1
+
+		if hs := m.wrapHeights(); m.list.Index() < len(hs) {
+			height = hs[m.list.Index()]
+		}
+
+		return bottom - height + 1, bottom
+	}
+
+	return m.list.Cursor(), m.list.Cursor()
+}
+
+// mergeMenuStyles merges the default styles with any existing
+// defined styles.
+func mergeMenuStyles(ms MenuStyles) MenuStyles {
+	bs := lipgloss.Border{Left: ms.ActionIndicator}
+
+	ms.Action = ms.Action.PaddingLeft(2)
+	ms.ActionSelected = ms.ActionSelected.BorderStyle(bs).BorderLeft(true).PaddingLeft(1)
+
+	return ms
+}
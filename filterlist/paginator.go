@@ -36,21 +36,23 @@ func NewPaginator(po Paginator) string {
 	total := po.Total
 	height := po.Height
 
-	if total == 0 {
+	if total == 0 || height < 1 {
 		return ""
 	}
 
+	// If there are more pages than the height, we truncate the list.
+	// This is not the best outcome but prevents overflow and rendering issues.
+	if total >= height {
+		total = height
+	}
+
 	ps := make([]string, total)
 	for p := range ps {
 		ps[p] = po.Styles.DotEmpty.String()
 	}
 
-	ps[pos] = po.Styles.DotFilled.String()
-
-	// If there are more pages than the height, we truncate the list.
-	// This is not the best outcome but prevents overflow and rendering issues.
-	if total >= po.Height {
-		ps = ps[:height]
+	if pos < total {
+		ps[pos] = po.Styles.DotFilled.String()
 	}
 
 	str := strings.Join(ps, "\n")
@@ -0,0 +1,261 @@
+package filterlist
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Pins is the pinned items model. Pinned items that match the filter are
+// shown in a block above the list and are identified by their title.
+type Pins struct {
+	// Store persists the pinned items.
+	Store PinStore
+
+	Styles PinStyles
+}
+
+// PinStyles is the styling of the pinned items.
+type PinStyles struct {
+	// Pinned item style.
+	Item lipgloss.Style
+
+	// The selected pinned item.
+	ItemSelected lipgloss.Style
+
+	// The glyph shown before pinned items.
+	Glyph lipgloss.Style
+}
+
+// PinStore loads and saves the identifiers of pinned items.
+type PinStore interface {
+	Load() ([]string, error)
+	Save([]string) error
+}
+
+// PinErrMsg is sent when the pinned items could not be saved.
+type PinErrMsg struct {
+	Err error
+}
+
+const (
+	defaultPinGlyph = "★"
+)
+
+// LoadPins loads the pinned items from the store.
+func (m *Model) LoadPins() error {
+	if m.Pins.Store == nil {
+		return nil
+	}
+
+	ids, err := m.Pins.Store.Load()
+	if err != nil {
+		return err
+	}
+
+	m.pins = ids
+	m.setPinned()
+	m.setListHeight()
+
+	return nil
+}
+
+// Pinned returns the pinned items that match the filter.
+func (m Model) Pinned() []list.Item {
+	return m.pinned
+}
+
+// IsPinned returns whether the item is pinned.
+func (m Model) IsPinned(item list.Item) bool {
+	id := itemID(item)
+
+	for _, p := range m.pins {
+		if p == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TogglePin pins or unpins the highlighted item and saves the pinned items
+// to the store.
+func (m *Model) TogglePin() tea.Cmd {
+	item := m.SelectedItem()
+	if item == nil {
+		return nil
+	}
+
+	id := itemID(item)
+
+	switch {
+	case m.IsPinned(item):
+		var pins []string
+
+		for _, p := range m.pins {
+			if p != id {
+				pins = append(pins, p)
+			}
+		}
+
+		m.pins = pins
+	default:
+		m.pins = append(m.pins, id)
+	}
+
+	m.setPinned()
+	m.setListHeight()
+
+	if m.Pins.Store == nil {
+		return nil
+	}
+
+	store := m.Pins.Store
+	pins := append([]string(nil), m.pins...)
+
+	return func() tea.Msg {
+		if err := store.Save(pins); err != nil {
+			return PinErrMsg{Err: err}
+		}
+
+		return nil
+	}
+}
+
+// updatePins moves the cursor between the pinned items and the list. It
+// returns true if the message was handled.
+func (m *Model) updatePins(msg tea.KeyMsg) bool {
+	pinned := len(m.Pinned())
+	if pinned == 0 {
+		return false
+	}
+
+	switch msg.String() {
+	case "up":
+		switch {
+		case m.pinSelected && m.pinIndex > 0:
+			m.pinIndex--
+		case m.pinSelected:
+		case m.list.Index() == 0:
+			m.pinSelected = true
+			m.pinIndex = pinned - 1
+		default:
+			return false
+		}
+
+		return true
+
+	case "down":
+		switch {
+		case !m.pinSelected:
+			return false
+		case m.pinIndex < pinned-1:
+			m.pinIndex++
+		default:
+			m.pinSelected = false
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// setPinned finds the pinned items that match the filter and keeps the
+// cursor within them. It must be called whenever the items, pins or filter
+// change.
+func (m *Model) setPinned() {
+	m.pinned = nil
+
+	if len(m.pins) > 0 {
+		items := make(map[string]list.Item, len(m.items))
+		for _, i := range m.items {
+			items[itemID(i)] = i
+		}
+
+		var pinned []list.Item
+
+		for _, id := range m.pins {
+			if i, ok := items[id]; ok {
+				pinned = append(pinned, i)
+			}
+		}
+
+		m.pinned = m.filterItems(pinned)
+	}
+
+	switch {
+	case len(m.pinned) == 0:
+		m.pinSelected = false
+		m.pinIndex = 0
+	case m.pinIndex >= len(m.pinned):
+		m.pinIndex = len(m.pinned) - 1
+	}
+}
+
+// pinnedHeight is the number of lines used by the pinned items. At most half
+// of the list is used.
+func (m Model) pinnedHeight(height int) int {
+	pinned := len(m.Pinned())
+	if pinned > height/2 {
+		return height / 2
+	}
+
+	return pinned
+}
+
+// pinnedView renders the pinned items.
+func (m Model) pinnedView(height int) string {
+	pinned := m.Pinned()
+	if len(pinned) > height {
+		pinned = pinned[:height]
+	}
+
+	lines := make([]string, len(pinned))
+
+	for idx, i := range pinned {
+		title := itemTitle(i)
+
+		switch {
+		case m.pinSelected && idx == m.pinIndex:
+			lines[idx] = m.Pins.Styles.ItemSelected.Render(title)
+		default:
+			lines[idx] = m.Pins.Styles.Glyph.String() + m.Pins.Styles.Item.Render(title)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// itemID is the identifier of an item used by pins, marks and snapshots.
+// Items are identified by their title.
+func itemID(item list.Item) string {
+	return itemTitle(item)
+}
+
+// itemTitle returns the title of default items or the filter value of
+// other items.
+func itemTitle(item list.Item) string {
+	if i, ok := item.(list.DefaultItem); ok {
+		return i.Title()
+	}
+
+	return item.FilterValue()
+}
+
+// mergePinStyles merges the default styles with any existing
+// defined styles.
+func mergePinStyles(ps PinStyles, ls ListStyles) PinStyles {
+	if ps.Glyph.Value() == "" {
+		ps.Glyph = ps.Glyph.SetString(defaultPinGlyph)
+	}
+
+	bs := lipgloss.Border{Left: ls.ItemIndicator}
+
+	ps.Glyph = ps.Glyph.MarginRight(1)
+	ps.ItemSelected = ps.ItemSelected.BorderStyle(bs).BorderLeft(true).PaddingLeft(1)
+
+	return ps
+}
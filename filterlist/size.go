@@ -0,0 +1,82 @@
+package filterlist
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// Minimum width of the model to show the paginator.
+	compactWidth = 12
+
+	// Selected indicator (1) + selected padding (1).
+	indicatorWidth = 2
+
+	// Margin of paginator (1) and paginator (1).
+	paginatorWidth = 2
+)
+
+// SetSize sets the width and height of the model within the minimum and
+// maximum constraints.
+func (m *Model) SetSize(width, height int) {
+	m.Width = constrain(width, m.MinWidth, m.MaxWidth)
+	m.Height = constrain(height, m.MinHeight, m.MaxHeight)
+}
+
+// showPaginator determines whether there is enough space for the paginator.
+func (m Model) showPaginator() bool {
+	return m.Width >= compactWidth
+}
+
+// contentWidth is the width available for the item titles and filter text.
+func (m Model) contentWidth() int {
+	width := m.Width - indicatorWidth
+	if m.showPaginator() {
+		width -= paginatorWidth
+	}
+
+	return max(width, 0)
+}
+
+// prompt is the text input prompt. The prompt text is removed when there
+// would be no space remaining for the filter text.
+func (m Model) prompt() string {
+	prompt := textInputPrompt(m.TextInput)
+	if lipgloss.Width(prompt) < m.contentWidth() {
+		return prompt
+	}
+
+	tio := m.TextInput
+	tio.PromptText = ""
+
+	return textInputPrompt(tio)
+}
+
+// constrain limits the value to the minimum and maximum. A zero minimum or
+// maximum is not applied.
+func constrain(v, low, high int) int {
+	if high > 0 && v > high {
+		v = high
+	}
+
+	if low > 0 && v < low {
+		v = low
+	}
+
+	return v
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
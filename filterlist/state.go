@@ -0,0 +1,65 @@
+package filterlist
+
+// State is a snapshot of the model that can be serialised and restored.
+type State struct {
+	// Filter text.
+	Query string `json:"query"`
+
+	// Identifier of the highlighted item.
+	Item string `json:"item,omitempty"`
+
+	// Current page of the list.
+	Page int `json:"page"`
+
+	// Identifiers of the marked items.
+	Marks []string `json:"marks,omitempty"`
+
+	// Focus state of the model.
+	Focus bool `json:"focus"`
+}
+
+// State returns a snapshot of the model.
+func (m Model) State() State {
+	s := State{
+		Query: m.Filter(),
+		Focus: m.focus,
+	}
+
+	s.Page, _ = m.pagination()
+
+	if item := m.SelectedItem(); item != nil {
+		s.Item = itemID(item)
+	}
+
+	for _, i := range m.Marked() {
+		s.Marks = append(s.Marks, itemID(i))
+	}
+
+	return s
+}
+
+// Restore restores the model to a snapshot. The items must be set before
+// restoring. The highlighted item is restored if it exists, otherwise the
+// first item of the page is highlighted.
+func (m *Model) Restore(s State) {
+	m.textInput.SetValue(s.Query)
+	m.textInput.CursorEnd()
+	m.setMatches()
+	m.setMarks(s.Marks)
+	m.setPinned()
+	m.setListHeight()
+
+	m.focus = s.Focus
+	m.pinSelected = false
+	m.menuOpen = false
+
+	for idx, i := range m.list.VisibleItems() {
+		if itemID(i) == s.Item {
+			m.list.Select(idx)
+
+			return
+		}
+	}
+
+	m.selectPage(s.Page)
+}
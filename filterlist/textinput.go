@@ -54,14 +54,11 @@ func (m Model) Filter() string {
 
 // setTextInput sets the default state of the text input.
 func (m *Model) setTextInput() {
-	m.textInput.Prompt = textInputPrompt(m.TextInput)
+	m.textInput.Prompt = m.prompt()
 	// Text input width is calculated excluding the prompt.
-	// Remove selected indicator (1) + selected padding (1) +
-	// margin of paginator (1) and paginator (1) = 4
-	m.textInput.Width = m.Width - lipgloss.Width(m.textInput.Prompt) - 4
+	m.textInput.Width = max(m.contentWidth()-lipgloss.Width(m.textInput.Prompt), 0)
 	m.textInput.Placeholder = m.TextInput.Placeholder
 	m.textInput.TextStyle = m.TextInput.Styles.Text
-	m.textInput.Prompt = textInputPrompt(m.TextInput)
 }
 
 // textInputPrompt merges the prompt mark and prompt text together.
@@ -0,0 +1,207 @@
+package filterlist
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
+)
+
+// wrapLines wraps the title to the width. Words longer than the width
+// are broken across lines.
+func wrapLines(title string, width int) []string {
+	if width < 1 {
+		return []string{title}
+	}
+
+	s := wrap.String(wordwrap.String(title, width), width)
+	lines := strings.Split(s, "\n")
+
+	for idx, l := range lines {
+		lines[idx] = strings.TrimRight(l, " ")
+	}
+
+	return lines
+}
+
+// wrapLayout is the number of lines of each visible item and the index of
+// the first item of each page when wrapping.
+type wrapLayout struct {
+	heights []int
+	pages   []int
+}
+
+// setWrap lays out the visible items when wrapping. It must be called
+// whenever the visible items or size of the list change.
+func (m *Model) setWrap() {
+	if !m.List.Wrap {
+		m.wrap = wrapLayout{}
+
+		return
+	}
+
+	items := m.list.VisibleItems()
+	width := m.wrapWidth()
+	height := m.list.Height()
+
+	hs := make([]int, len(items))
+
+	var (
+		pages []int
+		used  int
+	)
+
+	// Items are never taller than the list and pages are filled with as
+	// many items as fit within the height of the list.
+	for idx, i := range items {
+		h := min(len(wrapLines(itemTitle(i), width)), height)
+
+		if idx == 0 || used+h > height {
+			pages = append(pages, idx)
+			used = 0
+		}
+
+		hs[idx] = h
+		used += h
+	}
+
+	m.wrap = wrapLayout{
+		heights: hs,
+		pages:   pages,
+	}
+}
+
+// wrapHeights is the number of lines of each visible item.
+func (m Model) wrapHeights() []int {
+	return m.wrap.heights
+}
+
+// wrapPages is the index of the first item of each page.
+func (m Model) wrapPages() []int {
+	return m.wrap.pages
+}
+
+// wrapPage returns the page of the item index and the range of item
+// indexes on the page.
+func (m Model) wrapPage(index int) (page, start, end int) {
+	pages := m.wrapPages()
+	end = len(m.list.VisibleItems())
+
+	if len(pages) == 0 {
+		return 0, 0, end
+	}
+
+	// The page is the last page starting at or before the index.
+	page = sort.Search(len(pages), func(i int) bool {
+		return pages[i] > index
+	}) - 1
+	page = max(page, 0)
+	start = pages[page]
+
+	if page+1 < len(pages) {
+		end = pages[page+1]
+	}
+
+	return page, start, end
+}
+
+// wrapWidth is the width of the title excluding the selected indicator
+// and padding.
+func (m Model) wrapWidth() int {
+	return m.list.Width() - 2
+}
+
+// wrapView renders the current page of items with wrapped titles. Lines after
+// the first are indented to align with the title.
+func (m Model) wrapView() string {
+	items := m.list.VisibleItems()
+	if len(items) == 0 {
+		return m.list.View()
+	}
+
+	index := m.list.Index()
+	height := m.list.Height()
+	styles := m.List.Styles
+	_, start, end := m.wrapPage(index)
+
+	// Hanging indent of the selected item aligned after the indicator.
+	indent := styles.ItemSelected.Copy().BorderLeft(false).PaddingLeft(2)
+
+	var lines []string
+
+	for idx := start; idx < end; idx++ {
+		ls := wrapLines(itemTitle(items[idx]), m.wrapWidth())
+		if len(ls) > height {
+			ls = ls[:height]
+		}
+
+		for n, l := range ls {
+			switch {
+			case idx == index && !m.pinSelected && n == 0:
+				lines = append(lines, styles.ItemSelected.Render(l))
+			case idx == index && !m.pinSelected:
+				lines = append(lines, indent.Render(l))
+			case n == 0 && m.IsMarked(items[idx]):
+				lines = append(lines, styles.ItemMarked.Render(l))
+			default:
+				lines = append(lines, styles.Item.Render(l))
+			}
+		}
+	}
+
+	return lipgloss.NewStyle().Height(height).Render(strings.Join(lines, "\n"))
+}
+
+// wrapCursor is the line of the last row of the selected item on the page.
+func (m Model) wrapCursor() int {
+	index := m.list.Index()
+	hs := m.wrapHeights()
+	_, start, _ := m.wrapPage(index)
+
+	var row int
+	for idx := start; idx <= index && idx < len(hs); idx++ {
+		row += hs[idx]
+	}
+
+	return row - 1
+}
+
+// updateWrap handles the page keys of the list when wrapping. It returns
+// true if the message was handled.
+func (m *Model) updateWrap(msg tea.Msg) bool {
+	msgType, ok := msg.(tea.KeyMsg)
+	if !ok || !m.List.Wrap {
+		return false
+	}
+
+	switch {
+	case key.Matches(msgType, m.list.KeyMap.NextPage):
+		m.wrapNextPage()
+	case key.Matches(msgType, m.list.KeyMap.PrevPage):
+		m.wrapPrevPage()
+	default:
+		return false
+	}
+
+	return true
+}
+
+// wrapNextPage selects the first item of the next page.
+func (m *Model) wrapNextPage() {
+	_, _, end := m.wrapPage(m.list.Index())
+	if end < len(m.list.VisibleItems()) {
+		m.list.Select(end)
+	}
+}
+
+// wrapPrevPage selects the first item of the previous page.
+func (m *Model) wrapPrevPage() {
+	page, _, _ := m.wrapPage(m.list.Index())
+	if page > 0 {
+		m.list.Select(m.wrapPages()[page-1])
+	}
+}
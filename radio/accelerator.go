@@ -0,0 +1,110 @@
+package radio
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxDigits is the number of values that can be assigned a digit.
+const maxDigits = 9
+
+// accelerator returns the key that selects the value. Values without a
+// key are assigned the digits 1 to 9 when enabled.
+func (m Model) accelerator(idx int) string {
+	if idx < len(m.options) && m.options[idx].key != "" {
+		return m.options[idx].key
+	}
+
+	if m.Accelerators && idx < maxDigits {
+		return strconv.Itoa(idx + 1)
+	}
+
+	return ""
+}
+
+// updateRunes selects a value with the accelerator key or by
+// typing the start of the value. It returns true if the message
+// was handled.
+func (m *Model) updateRunes(msg tea.KeyMsg) bool {
+	if msg.Type != tea.KeyRunes {
+		m.typed = ""
+
+		return false
+	}
+
+	for idx := range m.values() {
+		if m.accelerator(idx) == msg.String() && !m.Disabled(idx) {
+			m.choose(idx)
+			m.typed = ""
+			m.scroll()
+
+			return true
+		}
+	}
+
+	if !m.TypeAhead {
+		return false
+	}
+
+	m.typeAhead(string(msg.Runes))
+
+	return true
+}
+
+// typeAhead selects the first value starting with the typed text. When
+// no value matches, the search restarts with the latest text after the
+// selected value so that repeating a letter cycles through the values.
+func (m *Model) typeAhead(s string) {
+	// A new search starts after the selected value.
+	from := m.selected()
+	if m.typed == "" {
+		from++
+	}
+
+	if idx, ok := m.findPrefix(m.typed+s, from); ok {
+		m.typed += s
+		m.choose(idx)
+		m.scroll()
+
+		return
+	}
+
+	m.typed = s
+
+	if idx, ok := m.findPrefix(s, m.selected()+1); ok {
+		m.choose(idx)
+		m.scroll()
+	}
+}
+
+// findPrefix finds the first enabled value starting with the prefix,
+// searching from the index and wrapping around. The search starts from
+// the first value when no value is selected.
+func (m Model) findPrefix(prefix string, from int) (int, bool) {
+	values := m.values()
+	n := len(values)
+	from = max(from, 0)
+
+	for i := 0; i < n; i++ {
+		idx := (from + i) % n
+
+		if hasPrefixFold(values[idx], prefix) && !m.Disabled(idx) {
+			return idx, true
+		}
+	}
+
+	return 0, false
+}
+
+// hasPrefixFold returns whether the string starts with the prefix
+// ignoring case.
+func hasPrefixFold(s, prefix string) bool {
+	if utf8.RuneCountInString(s) < utf8.RuneCountInString(prefix) {
+		return false
+	}
+
+	return strings.EqualFold(string([]rune(s)[:utf8.RuneCountInString(prefix)]), prefix)
+}
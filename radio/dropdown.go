@@ -0,0 +1,65 @@
+package radio
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Expanded returns whether the values are shown. Values are
+// only shown by the dropdown while it is open.
+func (m Model) Expanded() bool {
+	return !m.Dropdown || m.open
+}
+
+// updateDropdown opens the dropdown with the toggle key and closes it
+// with the toggle key to confirm or the cancel key to restore the value
+// selected before opening. It returns true if the message was handled.
+func (m *Model) updateDropdown(msg tea.KeyMsg) bool {
+	if !m.Dropdown {
+		return false
+	}
+
+	if !m.open {
+		if key.Matches(msg, m.KeyMap.Toggle) {
+			m.open = true
+			m.closed = m.selected()
+		}
+
+		// Keyboard input is ignored while closed.
+		return true
+	}
+
+	switch {
+	case key.Matches(msg, m.KeyMap.Toggle):
+		m.open = false
+	case key.Matches(msg, m.KeyMap.Cancel):
+		m.open = false
+		m.choose(m.closed)
+		m.scroll()
+	default:
+		return false
+	}
+
+	return true
+}
+
+// renderDropdown renders the heading and selected value on a single line.
+func (m Model) renderDropdown() string {
+	style := m.Styles.HeadingInactive
+	if m.focus {
+		style = m.Styles.HeadingActive
+	}
+
+	indicator := m.Styles.DropdownClosed.String()
+	if m.open {
+		indicator = m.Styles.DropdownOpen.String()
+	}
+
+	value := m.Styles.Values.Render(m.Value()) + " " + indicator
+
+	if m.Heading == "" {
+		return value
+	}
+
+	return style.Render(m.Heading+":") + " " + value
+}
@@ -0,0 +1,96 @@
+package radio
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// KeyMap is the key bindings of the Radio widget.
+type KeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Left   key.Binding
+	Right  key.Binding
+	First  key.Binding
+	Last   key.Binding
+	Clear  key.Binding
+	Toggle key.Binding
+	Cancel key.Binding
+}
+
+// DefaultKeyMap returns the default key bindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		Left: key.NewBinding(
+			key.WithKeys("left", "h"),
+			key.WithHelp("←/h", "left"),
+		),
+		Right: key.NewBinding(
+			key.WithKeys("right", "l"),
+			key.WithHelp("→/l", "right"),
+		),
+		First: key.NewBinding(
+			key.WithKeys("home", "g"),
+			key.WithHelp("g/home", "first"),
+		),
+		Last: key.NewBinding(
+			key.WithKeys("end", "G"),
+			key.WithHelp("G/end", "last"),
+		),
+		// Clear is unbound so that existing forms are not
+		// changed. Set the keys to allow the selection to be removed.
+		Clear: key.NewBinding(
+			key.WithHelp("del", "clear"),
+		),
+		Toggle: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "open/close"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
+	}
+}
+
+// ShortHelp returns the key bindings shown in the short help.
+func (m Model) ShortHelp() []key.Binding {
+	kb := []key.Binding{m.KeyMap.Up, m.KeyMap.Down}
+
+	if m.inline() {
+		kb = append(kb, m.KeyMap.Left, m.KeyMap.Right)
+	}
+
+	if m.Dropdown {
+		kb = append(kb, m.KeyMap.Toggle)
+	}
+
+	return kb
+}
+
+// FullHelp returns the key bindings shown in the full help.
+func (m Model) FullHelp() [][]key.Binding {
+	move := []key.Binding{m.KeyMap.Up, m.KeyMap.Down}
+
+	if m.inline() {
+		move = append(move, m.KeyMap.Left, m.KeyMap.Right)
+	}
+
+	kb := [][]key.Binding{
+		move,
+		{m.KeyMap.First, m.KeyMap.Last, m.KeyMap.Clear},
+	}
+
+	if m.Dropdown {
+		kb = append(kb, []key.Binding{m.KeyMap.Toggle, m.KeyMap.Cancel})
+	}
+
+	return kb
+}
@@ -0,0 +1,234 @@
+package radio
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Layout is the arrangement of the values.
+type Layout int
+
+const (
+	// Vertical stacks the values with one value per line.
+	Vertical Layout = iota
+
+	// Horizontal places the values inline, wrapping to the width.
+	Horizontal
+
+	// Grid places the values in aligned columns, reducing the columns to
+	// fit the width.
+	Grid
+)
+
+const (
+	defaultGap = 2
+)
+
+// cell is the position of a value in the layout.
+type cell struct {
+	index int
+	row   int
+	x     int
+	width int
+}
+
+// cells positions the rendered values according to the layout.
+func (m Model) cells(values []string) []cell {
+	if m.Segmented {
+		return segmentCells(values)
+	}
+
+	switch m.Layout {
+	case Horizontal:
+		return m.flowCells(values)
+	case Grid:
+		return m.gridCells(values)
+	default:
+		cs := make([]cell, len(values))
+		for idx, v := range values {
+			cs[idx] = cell{index: idx, row: idx, width: lipgloss.Width(v)}
+		}
+
+		return cs
+	}
+}
+
+// flowCells places the values inline, starting a new row when the next
+// value does not fit within the width.
+func (m Model) flowCells(values []string) []cell {
+	cs := make([]cell, len(values))
+
+	var row, x int
+
+	for idx, v := range values {
+		w := lipgloss.Width(v)
+
+		switch {
+		case idx == 0:
+		case m.Width > 0 && x+m.Gap+w > m.Width:
+			row++
+			x = 0
+		default:
+			x += m.Gap
+		}
+
+		cs[idx] = cell{index: idx, row: row, x: x, width: w}
+		x += w
+	}
+
+	return cs
+}
+
+// gridCells places the values in columns ordered across each row. The
+// number of columns is reduced until the grid fits within the width.
+func (m Model) gridCells(values []string) []cell {
+	cols := m.Columns
+	if cols < 1 {
+		cols = 1
+	}
+
+	if cols > len(values) {
+		cols = len(values)
+	}
+
+	widths := columnWidths(values, cols)
+	for m.Width > 0 && cols > 1 && gridWidth(widths, m.Gap) > m.Width {
+		cols--
+		widths = columnWidths(values, cols)
+	}
+
+	cs := make([]cell, len(values))
+
+	for idx, v := range values {
+		col := idx % cols
+
+		var x int
+		for _, w := range widths[:col] {
+			x += w + m.Gap
+		}
+
+		cs[idx] = cell{index: idx, row: idx / cols, x: x, width: lipgloss.Width(v)}
+	}
+
+	return cs
+}
+
+// renderCells renders each row of values at their positions. Values
+// spanning multiple lines extend the height of their row.
+func renderCells(values []string, cs []cell) []string {
+	var rows []string
+
+	for start := 0; start < len(cs); {
+		end := start
+		for end < len(cs) && cs[end].row == cs[start].row {
+			end++
+		}
+
+		rows = append(rows, renderRow(values, cs[start:end]))
+		start = end
+	}
+
+	return rows
+}
+
+// renderRow renders the lines of the values in a row.
+func renderRow(values []string, cs []cell) string {
+	blocks := make([][]string, len(cs))
+
+	var height int
+
+	for idx, c := range cs {
+		blocks[idx] = strings.Split(values[c.index], "\n")
+		height = max(height, len(blocks[idx]))
+	}
+
+	lines := make([]string, height)
+
+	for n := range lines {
+		var (
+			b strings.Builder
+			x int
+		)
+
+		for idx, c := range cs {
+			if n >= len(blocks[idx]) {
+				continue
+			}
+
+			b.WriteString(strings.Repeat(" ", max(c.x-x, 0)))
+			b.WriteString(blocks[idx][n])
+			x = c.x + lipgloss.Width(blocks[idx][n])
+		}
+
+		lines[n] = b.String()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// rowCells returns the cells of the row.
+func rowCells(cs []cell, row int) []cell {
+	var rcs []cell
+
+	for _, c := range cs {
+		if c.row == row {
+			rcs = append(rcs, c)
+		}
+	}
+
+	return rcs
+}
+
+// nearestCell returns the cell that is horizontally closest to the
+// position. The leftmost cell is chosen when cells are equally close.
+func nearestCell(cs []cell, x int) cell {
+	nearest := cs[0]
+
+	for _, c := range cs[1:] {
+		if abs(c.x-x) < abs(nearest.x-x) {
+			nearest = c
+		}
+	}
+
+	return nearest
+}
+
+// columnWidths is the width of the widest value in each column.
+func columnWidths(values []string, cols int) []int {
+	widths := make([]int, cols)
+
+	for idx, v := range values {
+		if w := lipgloss.Width(v); w > widths[idx%cols] {
+			widths[idx%cols] = w
+		}
+	}
+
+	return widths
+}
+
+// gridWidth is the total width of the columns and the gaps between them.
+func gridWidth(widths []int, gap int) int {
+	total := gap * (len(widths) - 1)
+	for _, w := range widths {
+		total += w
+	}
+
+	return total
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+
+	return a
+}
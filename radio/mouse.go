@@ -0,0 +1,83 @@
+package radio
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// updateMouse selects the value clicked and moves the widget with
+// the mouse wheel.
+func (m *Model) updateMouse(msg tea.MouseMsg) {
+	switch msg.Type {
+	case tea.MouseWheelUp:
+		if m.Expanded() {
+			m.Previous()
+		}
+	case tea.MouseWheelDown:
+		if m.Expanded() {
+			m.Next()
+		}
+	case tea.MouseLeft:
+		m.click(msg.X-m.X, msg.Y-m.Y)
+	}
+}
+
+// click selects the value at the position relative to the widget.
+// Clicking the dropdown line opens or closes the dropdown.
+func (m *Model) click(x, y int) {
+	line := y
+
+	// The heading or dropdown uses the first line.
+	if m.Dropdown || m.Heading != "" {
+		if line == 0 && m.Dropdown {
+			m.open = !m.open
+			m.closed = m.selected()
+		}
+
+		line--
+	}
+
+	// The validation error uses the next line.
+	if m.err != nil {
+		line--
+	}
+
+	if line < 0 || !m.Expanded() || len(m.values()) == 0 {
+		return
+	}
+
+	values := m.renderValues()
+	cs := m.cells(values)
+	heights := rowHeights(renderCells(values, cs))
+	start, end := m.rowRange(heights, m.selectedRow(cs))
+
+	// The overflow indicator uses the line above the rows.
+	if start > 0 {
+		line--
+	}
+
+	for row := start; row < end && line >= 0; row++ {
+		if line < heights[row] {
+			m.clickRow(rowCells(cs, row), x)
+
+			return
+		}
+
+		line -= heights[row]
+	}
+}
+
+// clickRow selects the value of the row at the horizontal position.
+func (m *Model) clickRow(cs []cell, x int) {
+	for _, c := range cs {
+		if x < c.x || x >= c.x+c.width || m.Disabled(c.index) {
+			continue
+		}
+
+		m.choose(c.index)
+		m.typed = ""
+		m.open = false
+		m.scroll()
+
+		return
+	}
+}
@@ -0,0 +1,150 @@
+package radio
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Option is a selectable option of the typed Radio widget.
+type Option[T any] struct {
+	// Value returned when the option is selected.
+	Value T
+
+	// Label is the text shown for the option.
+	Label string
+
+	// Description is shown dimmed with the label.
+	Description string
+
+	// Disabled options are shown but cannot be selected.
+	Disabled bool
+
+	// Key is the accelerator key that selects the option.
+	Key string
+}
+
+// Typed is the Bubble Tea model for the Radio widget with
+// options of any type. It has the fields and methods of Model
+// except the values, which are only set from the options.
+type Typed[T any] struct {
+	model
+
+	// Options are all the typed options selectable.
+	options []Option[T]
+}
+
+// model is the Radio model embedded unexported in the typed
+// model so the values cannot be replaced without the options.
+type model = Model
+
+// option is the description, state and key of a value.
+type option struct {
+	description string
+	disabled    bool
+	key         string
+}
+
+// NewTyped creates a new typed model with default styles.
+func NewTyped[T any](opts ...Option[T]) Typed[T] {
+	m := Typed[T]{
+		model: New(),
+	}
+
+	m.SetOptions(opts)
+
+	return m
+}
+
+// Update is the Bubble Tea update loop.
+func (m Typed[T]) Update(msg tea.Msg) (Typed[T], tea.Cmd) {
+	var cmd tea.Cmd
+
+	m.model, cmd = m.model.Update(msg)
+
+	return m, cmd
+}
+
+// SetOptions replaces the options. The labels are used as
+// the values of the underlying model.
+func (m *Typed[T]) SetOptions(opts []Option[T]) {
+	m.options = opts
+	m.model.Values = make([]string, len(opts))
+	m.model.options = make([]option, len(opts))
+
+	for idx, o := range opts {
+		m.model.Values[idx] = o.Label
+		m.model.options[idx] = option{
+			description: o.Description,
+			disabled:    o.Disabled,
+			key:         o.Key,
+		}
+	}
+
+	m.setIndex(m.selected())
+}
+
+// Options returns all the options.
+func (m Typed[T]) Options() []Option[T] {
+	return m.options
+}
+
+// Values returns the labels of the options. The values are
+// only changed by setting the options.
+func (m Typed[T]) Values() []string {
+	return append([]string(nil), m.model.Values...)
+}
+
+// Selected is the value of the option currently selected. The
+// zero value is returned when the other option is selected.
+func (m Typed[T]) Selected() T {
+	var v T
+
+	if idx := m.selected(); idx >= 0 && idx < len(m.options) {
+		v = m.options[idx].Value
+	}
+
+	return v
+}
+
+// description returns the description of the value.
+func (m Model) description(idx int) string {
+	if idx >= 0 && idx < len(m.options) {
+		return m.options[idx].description
+	}
+
+	return ""
+}
+
+// Disabled returns whether the value at the index is disabled.
+func (m Model) Disabled(idx int) bool {
+	return idx >= 0 && idx < len(m.options) && m.options[idx].disabled
+}
+
+// setIndex selects the index or the closest enabled index after
+// it. No value remains selected when unselected or when every
+// value is disabled.
+func (m *Model) setIndex(idx int) {
+	// Setting the options is not a choice so the widget
+	// can still start unselected.
+	chosen := m.chosen
+
+	if n := len(m.values()); idx >= n {
+		idx = max(n-1, 0)
+	}
+
+	m.choose(idx)
+
+	if m.Disabled(idx) {
+		m.step(1)
+	}
+
+	if m.Disabled(m.selected()) {
+		m.step(-1)
+	}
+
+	if m.Disabled(m.selected()) {
+		m.choose(unselected)
+	}
+
+	m.chosen = chosen
+	m.scroll()
+}
@@ -0,0 +1,60 @@
+package radio
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// values returns the values including the other option.
+func (m Model) values() []string {
+	if m.Other == "" {
+		return m.Values
+	}
+
+	return append(m.Values[:len(m.Values):len(m.Values)], m.Other)
+}
+
+// Custom returns whether the other option is selected and the
+// value is the text entered.
+func (m Model) Custom() bool {
+	return m.Other != "" && m.selected() == len(m.Values)
+}
+
+// updateOther sends the keyboard input to the text input while the other
+// option is selected. Keys that move to another value are not handled.
+func (m *Model) updateOther(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if !m.Custom() {
+		return nil, false
+	}
+
+	// Letters are always entered as text.
+	if msg.Type != tea.KeyRunes {
+		switch {
+		case key.Matches(msg, m.KeyMap.Up, m.KeyMap.Down):
+			return nil, false
+		case key.Matches(msg, m.KeyMap.Left) && m.input.Position() == 0:
+			return nil, false
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.input, cmd = m.input.Update(msg)
+
+	return cmd, true
+}
+
+// setOther focuses the text input while the other option is selected
+// and shown, and the model is in focus.
+func (m *Model) setOther() tea.Cmd {
+	m.input.Placeholder = m.OtherPlaceholder
+
+	switch {
+	case m.focus && m.Custom() && m.Expanded() && !m.input.Focused():
+		return m.input.Focus()
+	case (!m.focus || !m.Custom() || !m.Expanded()) && m.input.Focused():
+		m.input.Blur()
+	}
+
+	return nil
+}
@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 // Model is the Bubble Tea model for the Radio widget.
@@ -20,40 +23,218 @@ type Model struct {
 	// back to the first or last value.
 	Loop bool
 
+	// Layout is the arrangement of the values.
+	Layout Layout
+
+	// Columns is the number of columns of the grid layout.
+	Columns int
+
+	// Gap is the spacing between values placed on the same line.
+	Gap int
+
+	// Width is the width the horizontal and grid layouts
+	// wrap to and the segments fill, truncating the bar when
+	// too narrow. Zero is unconstrained.
+	Width int
+
+	// Height is the number of lines the values are shown
+	// within, scrolling to keep the selected value visible.
+	// Overflow indicators are dropped when the height is too
+	// short for them. Zero is unconstrained.
+	Height int
+
+	// Accelerators assigns the digits 1 to 9 as keys to select
+	// the first values. Keys set on options take precedence.
+	Accelerators bool
+
+	// TypeAhead selects the first value starting with the
+	// letters typed.
+	TypeAhead bool
+
+	// Other is the label of a final option that reveals a text
+	// input to enter a custom value when selected.
+	Other string
+
+	// OtherPlaceholder is the placeholder of the text input.
+	OtherPlaceholder string
+
+	// Segmented shows the values as a bar of joined segments
+	// with the selected segment highlighted.
+	Segmented bool
+
+	// Dropdown shows the heading and selected value on a single
+	// line, opening to show the values when enter is pressed.
+	Dropdown bool
+
+	// Required is whether a value must be selected
+	// to be valid.
+	Required bool
+
+	// Unselected starts the widget with no value selected
+	// instead of the first value.
+	Unselected bool
+
+	// InlineDescriptions renders descriptions beside the
+	// labels instead of below them.
+	InlineDescriptions bool
+
+	// X and Y are the position of the top left corner of the
+	// widget on the screen used to find the value clicked.
+	X int
+	Y int
+
+	// KeyMap is the key bindings of the widget.
+	KeyMap KeyMap
+
 	// Styles all the widget components.
 	Styles Styles
 
 	// Index is the index of all possible values.
 	index int
 
+	// Chosen is whether a value has been selected or
+	// cleared since the widget was created.
+	chosen bool
+
+	// Options are the descriptions and states of the values.
+	options []option
+
+	// Offset is the first row shown when scrolling.
+	offset int
+
+	// Typed is the text typed to select a value.
+	typed string
+
+	// Input is the text input of the other option.
+	input textinput.Model
+
+	// Err is the error of the last validation.
+	err error
+
+	// Open is whether the dropdown is showing the values.
+	open bool
+
+	// Closed is the index selected before the dropdown opened.
+	closed int
+
 	// Focus is the state that determines whether
 	// keyboard inputs should be accepted.
 	focus bool
 }
 
+// ChangedMsg is sent when the selected value has changed, including
+// when the text of the other option is edited.
+type ChangedMsg struct {
+	// Index of the value selected.
+	Index int
+
+	// Value selected.
+	Value string
+
+	// Previous is the index of the value previously selected.
+	Previous int
+}
+
 // New creates a new model with default styles.
 func New() Model {
+	input := textinput.New()
+	input.Prompt = ""
+
 	return Model{
+		Gap:    defaultGap,
+		KeyMap: DefaultKeyMap(),
 		Styles: defaultStyles(),
+		input:  input,
 	}
 }
 
 // Update is the Bubble Tea update loop.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	previous := m.selected()
+	value := m.Value()
+
+	cmds = append(cmds, m.setOther())
+
 	if m.focus {
-		//nolint:gocritic
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
-			switch msg.String() {
-			case "down":
-				m.Next()
-			case "up":
-				m.Previous()
-			}
+			cmds = append(cmds, m.updateInput(msg))
+		case tea.MouseMsg:
+			m.updateMouse(msg)
 		}
 	}
 
-	return m, nil
+	// The error is removed once the value is valid.
+	if m.err != nil {
+		m.Validate()
+	}
+
+	cmds = append(cmds, m.setOther(), m.changed(previous, value))
+
+	return m, tea.Batch(cmds...)
+}
+
+// updateInput handles the keyboard input. The dropdown handles opening
+// and closing, then the text input of the other option receives input
+// before moving the widget.
+func (m *Model) updateInput(msg tea.KeyMsg) tea.Cmd {
+	if m.updateDropdown(msg) {
+		return nil
+	}
+
+	if cmd, ok := m.updateOther(msg); ok {
+		return cmd
+	}
+
+	m.updateKeys(msg)
+
+	return nil
+}
+
+// updateKeys moves the widget with the keyboard input. Letters
+// select values with accelerator keys or type ahead before
+// matching the key bindings.
+func (m *Model) updateKeys(msg tea.KeyMsg) {
+	if m.updateRunes(msg) {
+		return
+	}
+
+	switch {
+	case key.Matches(msg, m.KeyMap.Down):
+		m.Down()
+	case key.Matches(msg, m.KeyMap.Up):
+		m.Up()
+	case key.Matches(msg, m.KeyMap.Right):
+		m.Right()
+	case key.Matches(msg, m.KeyMap.Left):
+		m.Left()
+	case key.Matches(msg, m.KeyMap.First):
+		m.First()
+	case key.Matches(msg, m.KeyMap.Last):
+		m.Last()
+	case key.Matches(msg, m.KeyMap.Clear):
+		m.Clear()
+	}
+}
+
+// changed returns a command that sends a changed message when the
+// selected index or value is no longer the previous index or value.
+func (m Model) changed(previous int, value string) tea.Cmd {
+	if m.selected() == previous && m.Value() == value {
+		return nil
+	}
+
+	msg := ChangedMsg{
+		Index:    m.selected(),
+		Value:    m.Value(),
+		Previous: previous,
+	}
+
+	return func() tea.Msg {
+		return msg
+	}
 }
 
 // View is the Bubble Text text renderer.
@@ -61,26 +242,39 @@ func (m Model) View() string {
 	return m.renderRadio()
 }
 
-// Value is the value currently selected.
+// Value is the value currently selected. The text entered is
+// the value when the other option is selected.
 func (m Model) Value() string {
-	if len(m.Values) == 0 {
+	values := m.values()
+	idx := m.selected()
+
+	switch {
+	case len(values) == 0 || idx < 0:
 		return ""
+	case m.Custom():
+		return m.input.Value()
 	}
 
-	return m.Values[m.index]
+	return values[idx]
 }
 
 // Index is the currently selected index.
 func (m Model) Index() int {
-	return m.index
+	return m.selected()
 }
 
 // renderRadio rends the radio widget.
 func (m Model) renderRadio() string {
 	var arr []string
 
+	switch {
+	// The dropdown replaces the heading with the
+	// heading and selected value.
+	case m.Dropdown:
+		arr = append(arr, m.renderDropdown())
+
 	// If the heading is not empty we add it.
-	if m.Heading != "" {
+	case m.Heading != "":
 		heading := m.Styles.HeadingInactive.Render(m.Heading)
 		if m.focus {
 			heading = m.Styles.HeadingActive.Render(m.Heading)
@@ -89,69 +283,255 @@ func (m Model) renderRadio() string {
 		arr = append(arr, heading)
 	}
 
-	for idx, val := range m.Values {
+	// The validation error is shown below the heading.
+	if m.err != nil {
+		arr = append(arr, m.Styles.Error.Render(m.err.Error()))
+	}
+
+	switch {
+	case len(m.values()) == 0 || !m.Expanded():
+	// The segments are shown on a single line with the text
+	// input of the other option following.
+	case m.Segmented:
+		bar := m.renderBar(m.renderValues())
+		if m.Custom() {
+			bar = fmt.Sprintf("%v %v", bar, m.input.View())
+		}
+
+		arr = append(arr, bar)
+	default:
+		values := m.renderValues()
+		cs := m.cells(values)
+		arr = append(arr, m.viewport(renderCells(values, cs), m.selectedRow(cs))...)
+	}
+
+	return strings.Join(arr, "\n")
+}
+
+// renderValues renders each value with its state.
+func (m Model) renderValues() []string {
+	if m.Segmented {
+		return m.renderSegments()
+	}
+
+	values := make([]string, len(m.values()))
+
+	for idx, val := range m.values() {
 		state := m.Styles.Inactive.String()
 
 		// If the index matches the current value
 		// mark the value as active and render with
 		// the active element.
-		if idx == m.index {
+		if idx == m.selected() {
 			state = m.Styles.Active.String()
 		}
 
-		arr = append(arr, fmt.Sprintf("%v %v", state, m.Styles.Values.Render(val)))
+		label := m.Styles.Values.Render(val)
+		if m.Disabled(idx) {
+			label = m.Styles.Disabled.Render(val)
+		}
+
+		value := fmt.Sprintf("%v %v", state, label)
+
+		// The accelerator key is shown before the label.
+		if key := m.accelerator(idx); key != "" {
+			value = fmt.Sprintf("%v %v %v", state, m.Styles.Accelerator.Render(key), label)
+		}
+
+		// The text input follows the other option when selected.
+		if idx == m.selected() && m.Custom() {
+			value = fmt.Sprintf("%v %v", value, m.input.View())
+		}
+
+		// Descriptions are dimmed and either follow the
+		// label or are aligned below it.
+		if desc := m.description(idx); desc != "" {
+			desc = m.Styles.Description.Render(desc)
+
+			switch {
+			case m.InlineDescriptions:
+				value = fmt.Sprintf("%v %v", value, desc)
+			default:
+				indent := strings.Repeat(" ", lipgloss.Width(state)+1)
+				value = fmt.Sprintf("%v\n%v%v", value, indent, desc)
+			}
+		}
+
+		values[idx] = value
 	}
 
-	return strings.Join(arr, "\n")
+	return values
 }
 
-// Next moves the widget to the next value.
-func (m *Model) Next() {
-	switch {
-	// If loop is enabled and the we are at the
-	// end of the list, we reset to the first item.
-	case m.index == (len(m.Values)-1) && m.Loop:
-		m.index = 0
+// First moves the widget to the first value.
+func (m *Model) First() {
+	m.jump(1)
+}
 
-		return
+// Last moves the widget to the last value.
+func (m *Model) Last() {
+	m.jump(-1)
+}
 
-	// If we are at the end of the index, do nothing.
-	case m.index == len(m.Values)-1:
-		return
+// jump moves the widget to the first value that is not disabled
+// from the start or end of the list.
+func (m *Model) jump(n int) {
+	idx := m.selected()
+	m.choose(unselected)
+	m.step(n)
+
+	// If every value is disabled, do nothing.
+	if m.selected() == unselected {
+		m.choose(idx)
 	}
+}
 
-	// Increment the index.
-	m.index++
+// Next moves the widget to the next value.
+func (m *Model) Next() {
+	m.step(1)
 }
 
 // Previous moves the widget to the previous value.
 func (m *Model) Previous() {
-	switch {
-	// If loop is enabled and we are at the
-	// start of the list, we jump to the list item.
-	case m.index == 0 && m.Loop:
-		m.index = len(m.Values) - 1
+	m.step(-1)
+}
+
+// step moves the widget in the direction to the
+// closest value that is not disabled.
+func (m *Model) step(n int) {
+	idx := m.selected()
+	values := m.values()
 
+	// With no value selected moving backwards
+	// starts from the end of the list.
+	if idx < 0 && n < 0 {
+		idx = len(values)
+	}
+
+	for range values {
+		idx += n
+
+		switch {
+		case idx >= 0 && idx < len(values):
+		// If loop is enabled and we are at the
+		// end of the list, we reset to the first item.
+		case idx >= len(values) && m.Loop:
+			idx = 0
+		// If loop is enabled and we are at the
+		// start of the list, we jump to the last item.
+		case idx < 0 && m.Loop:
+			idx = len(values) - 1
+		// If we are at the boundary of the index, do nothing.
+		default:
+			return
+		}
+
+		if !m.Disabled(idx) {
+			m.choose(idx)
+			m.scroll()
+
+			return
+		}
+	}
+}
+
+// Down moves the widget to the value below in the layout.
+func (m *Model) Down() {
+	m.moveRow(1)
+}
+
+// Up moves the widget to the value above in the layout.
+func (m *Model) Up() {
+	m.moveRow(-1)
+}
+
+// Right moves the widget to the next value when the
+// values are placed on the same line.
+func (m *Model) Right() {
+	if m.inline() {
+		m.Next()
+	}
+}
+
+// Left moves the widget to the previous value when the
+// values are placed on the same line.
+func (m *Model) Left() {
+	if m.inline() {
+		m.Previous()
+	}
+}
+
+// moveRow moves the widget by a number of rows to the
+// value closest to the current value.
+func (m *Model) moveRow(n int) {
+	cs := m.cells(m.renderValues())
+	if len(cs) == 0 {
 		return
+	}
+
+	// With no value selected we move to the
+	// first or last value.
+	if m.selected() < 0 {
+		m.step(n)
 
-	// If we are at the beginning of the index, do nothing.
-	case m.index == 0:
 		return
 	}
 
-	// Reduce the index.
-	m.index--
+	current := cs[m.selected()]
+	last := cs[len(cs)-1].row
+	row := current.row
+
+	// Rows without any enabled values are skipped.
+	for i := 0; i < last; i++ {
+		row += n
+
+		switch {
+		case row >= 0 && row <= last:
+		// If loop is enabled we wrap around to the
+		// first or last row.
+		case m.Loop && row < 0:
+			row = last
+		case m.Loop:
+			row = 0
+		// If we are at the first or last row, do nothing.
+		default:
+			return
+		}
+
+		var enabled []cell
+
+		for _, c := range rowCells(cs, row) {
+			if !m.Disabled(c.index) {
+				enabled = append(enabled, c)
+			}
+		}
+
+		if len(enabled) > 0 {
+			m.choose(nearestCell(enabled, current.x).index)
+			m.scroll()
+
+			return
+		}
+	}
 }
 
-// Select sets the index to the heading provided.
+// Select sets the index to the heading provided. When no value
+// matches the other option is selected with the value entered.
 func (m *Model) Select(val string) {
 	for idx, v := range m.Values {
-		if v == val {
-			m.index = idx
+		if v == val && !m.Disabled(idx) {
+			m.choose(idx)
+			m.scroll()
 
-			break
+			return
 		}
 	}
+
+	if m.Other != "" {
+		m.choose(len(m.Values))
+		m.input.SetValue(val)
+		m.scroll()
+	}
 }
 
 // Focused return the focus state of the model.
@@ -169,4 +549,6 @@ func (m *Model) Focus() {
 // is blurred it cannot receive keyboard input.
 func (m *Model) Blur() {
 	m.focus = false
+	m.open = false
+	m.input.Blur()
 }
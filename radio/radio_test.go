@@ -1,12 +1,15 @@
 package radio_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/mikelorant/teaset/radio"
 	"github.com/mikelorant/teaset/uitest"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/assert"
 )
@@ -18,6 +21,10 @@ func Test(t *testing.T) {
 		heading string
 		values  []string
 		loop    bool
+		layout  radio.Layout
+		columns int
+		width   int
+		height  int
 		model   func(m radio.Model) radio.Model
 	}
 
@@ -215,6 +222,1148 @@ func Test(t *testing.T) {
 				},
 			},
 		},
+		"horizontal": {
+			args: args{
+				heading: "test",
+				values:  []string{"Small", "Medium", "Large"},
+				layout:  radio.Horizontal,
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 1, m.Index(), "Index")
+					assert.Equal(t, "Medium", m.Value(), "Value")
+				},
+			},
+		},
+		"horizontal_wrap": {
+			args: args{
+				heading: "test",
+				values:  []string{"Small", "Medium", "Large", "Extra Large"},
+				layout:  radio.Horizontal,
+				width:   30,
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 3, m.Index(), "Index")
+					assert.Equal(t, "Extra Large", m.Value(), "Value")
+				},
+			},
+		},
+		"grid": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7"},
+				layout:  radio.Grid,
+				columns: 3,
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 6, m.Index(), "Index")
+					assert.Equal(t, "7", m.Value(), "Value")
+				},
+			},
+		},
+		"grid_loop": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6"},
+				layout:  radio.Grid,
+				columns: 3,
+				loop:    true,
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 4, m.Index(), "Index")
+					assert.Equal(t, "5", m.Value(), "Value")
+				},
+			},
+		},
+		"grid_width": {
+			args: args{
+				heading: "test",
+				values:  []string{"Alpha", "Beta", "Gamma", "Delta", "Epsilon"},
+				layout:  radio.Grid,
+				columns: 3,
+				width:   20,
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 0, m.Index(), "Index")
+					assert.Equal(t, "Alpha", m.Value(), "Value")
+				},
+			},
+		},
+		"vertical_right": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 0, m.Index(), "Index")
+					assert.Equal(t, "1", m.Value(), "Value")
+				},
+			},
+		},
+		"height": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+				height:  5,
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 0, m.Index(), "Index")
+				},
+			},
+		},
+		"height_down": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+				height:  5,
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					for i := 0; i < 6; i++ {
+						m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					}
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 6, m.Index(), "Index")
+					assert.Equal(t, "7", m.Value(), "Value")
+				},
+			},
+		},
+		"height_short": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				height:  2,
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 2, m.Index(), "Index")
+				},
+			},
+		},
+		"height_one": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				height:  1,
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					return m
+				},
+			},
+		},
+		"height_up": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+				height:  5,
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					for i := 0; i < 6; i++ {
+						m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					}
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 5, m.Index(), "Index")
+					assert.Equal(t, "6", m.Value(), "Value")
+				},
+			},
+		},
+		"height_loop": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+				height:  5,
+				loop:    true,
+				model: func(m radio.Model) radio.Model {
+					m.Previous()
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 9, m.Index(), "Index")
+					assert.Equal(t, "10", m.Value(), "Value")
+				},
+			},
+		},
+		"changed": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					var cmd tea.Cmd
+
+					m.Focus()
+					m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					assert.Contains(t, cmdMsgs(cmd), radio.ChangedMsg{Index: 1, Value: "2", Previous: 0})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 1, m.Index(), "Index")
+					assert.Equal(t, "2", m.Value(), "Value")
+				},
+			},
+		},
+		"changed_boundary": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					var cmd tea.Cmd
+
+					m.Focus()
+					m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+
+					assert.Nil(t, cmd)
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 0, m.Index(), "Index")
+				},
+			},
+		},
+		"accelerators": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Accelerators = true
+					m.Focus()
+					m, _ = m.Update(uitest.KeyPress('4'))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 3, m.Index(), "Index")
+					assert.Equal(t, "4", m.Value(), "Value")
+				},
+			},
+		},
+		"type_ahead": {
+			args: args{
+				heading: "test",
+				values:  []string{"Apple", "Banana", "Blueberry", "Cherry"},
+				model: func(m radio.Model) radio.Model {
+					m.TypeAhead = true
+					m.Focus()
+					m, _ = m.Update(uitest.KeyPress('b'))
+					m, _ = m.Update(uitest.KeyPress('l'))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 2, m.Index(), "Index")
+					assert.Equal(t, "Blueberry", m.Value(), "Value")
+				},
+			},
+		},
+		"type_ahead_unselected": {
+			args: args{
+				heading: "test",
+				values:  []string{"Small", "Medium", "Large"},
+				model: func(m radio.Model) radio.Model {
+					m.Unselected = true
+					m.TypeAhead = true
+					m.Focus()
+					m, _ = m.Update(uitest.KeyPress('z'))
+					m, _ = m.Update(uitest.KeyPress('q'))
+					m, _ = m.Update(uitest.KeyPress('m'))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 1, m.Index(), "Index")
+					assert.Equal(t, "Medium", m.Value(), "Value")
+				},
+			},
+		},
+		"type_ahead_clear": {
+			args: args{
+				heading: "test",
+				values:  []string{"Small", "Medium", "Large"},
+				model: func(m radio.Model) radio.Model {
+					m.TypeAhead = true
+					m.Focus()
+					m.Clear()
+					m, _ = m.Update(uitest.KeyPress('z'))
+					m, _ = m.Update(uitest.KeyPress('s'))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 0, m.Index(), "Index")
+					assert.Equal(t, "Small", m.Value(), "Value")
+				},
+			},
+		},
+		"type_ahead_repeat": {
+			args: args{
+				heading: "test",
+				values:  []string{"Apple", "Banana", "Blueberry", "Cherry"},
+				model: func(m radio.Model) radio.Model {
+					m.TypeAhead = true
+					m.Focus()
+					m, _ = m.Update(uitest.KeyPress('B'))
+					m, _ = m.Update(uitest.KeyPress('b'))
+					m, _ = m.Update(uitest.KeyPress('b'))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 1, m.Index(), "Index")
+					assert.Equal(t, "Banana", m.Value(), "Value")
+				},
+			},
+		},
+		"type_ahead_disabled": {
+			args: args{
+				heading: "test",
+				values:  []string{"Apple", "Banana", "Blueberry", "Cherry"},
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(uitest.KeyPress('c'))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 0, m.Index(), "Index")
+				},
+			},
+		},
+		"other": {
+			args: args{
+				heading: "test",
+				values:  []string{"Small", "Medium", "Large"},
+				model: func(m radio.Model) radio.Model {
+					m.Other = "Other…"
+					m.Focus()
+					for i := 0; i < 3; i++ {
+						m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					}
+					m, _ = m.Update(uitest.KeyPress('X'))
+					m, _ = m.Update(uitest.KeyPress('L'))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.True(t, m.Custom())
+					assert.Equal(t, 3, m.Index(), "Index")
+					assert.Equal(t, "XL", m.Value(), "Value")
+				},
+			},
+		},
+		"other_changed": {
+			args: args{
+				heading: "test",
+				values:  []string{"Small", "Medium", "Large"},
+				model: func(m radio.Model) radio.Model {
+					var cmd tea.Cmd
+
+					m.Other = "Other…"
+					m.Focus()
+					m.Select("")
+					m, cmd = m.Update(uitest.KeyPress('x'))
+
+					assert.Contains(t, cmdMsgs(cmd), radio.ChangedMsg{Index: 3, Value: "x", Previous: 3})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, "x", m.Value(), "Value")
+				},
+			},
+		},
+		"other_up": {
+			args: args{
+				heading: "test",
+				values:  []string{"Small", "Medium", "Large"},
+				model: func(m radio.Model) radio.Model {
+					m.Other = "Other…"
+					m.Focus()
+					for i := 0; i < 3; i++ {
+						m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					}
+					m, _ = m.Update(uitest.KeyPress('X'))
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.False(t, m.Custom())
+					assert.Equal(t, "Large", m.Value(), "Value")
+				},
+			},
+		},
+		"other_left": {
+			args: args{
+				heading: "test",
+				values:  []string{"Small", "Medium", "Large"},
+				layout:  radio.Horizontal,
+				model: func(m radio.Model) radio.Model {
+					m.Other = "Other…"
+					m.Focus()
+					m.Select("L")
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+
+					assert.True(t, m.Custom())
+
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, "Large", m.Value(), "Value")
+				},
+			},
+		},
+		"other_select": {
+			args: args{
+				heading: "test",
+				values:  []string{"Small", "Medium", "Large"},
+				model: func(m radio.Model) radio.Model {
+					m.Other = "Other…"
+					m.Select("Huge")
+					m.Blur()
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.True(t, m.Custom())
+					assert.Equal(t, "Huge", m.Value(), "Value")
+				},
+			},
+		},
+		"clear": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					var cmd tea.Cmd
+
+					m.KeyMap.Clear.SetKeys("backspace", "delete")
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+
+					assert.Contains(t, cmdMsgs(cmd), radio.ChangedMsg{Index: -1, Value: "", Previous: 1})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.False(t, m.HasValue())
+					assert.Equal(t, -1, m.Index(), "Index")
+					assert.Empty(t, m.Value(), "Value")
+				},
+			},
+		},
+		"clear_unbound": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 0, m.Index(), "Index")
+				},
+			},
+		},
+		"unselected": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Unselected = true
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.False(t, m.HasValue())
+					assert.Equal(t, -1, m.Index(), "Index")
+					assert.Empty(t, m.Value(), "Value")
+				},
+			},
+		},
+		"unselected_down": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					var cmd tea.Cmd
+
+					m.Unselected = true
+					m.Focus()
+					m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					assert.Contains(t, cmdMsgs(cmd), radio.ChangedMsg{Index: 0, Value: "1", Previous: -1})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.True(t, m.HasValue())
+					assert.Equal(t, "1", m.Value(), "Value")
+				},
+			},
+		},
+		"clear_up": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Clear()
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.True(t, m.HasValue())
+					assert.Equal(t, 4, m.Index(), "Index")
+					assert.Equal(t, "5", m.Value(), "Value")
+				},
+			},
+		},
+		"required": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Required = true
+					m.Clear()
+
+					assert.ErrorIs(t, m.Validate(), radio.ErrRequired)
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.ErrorIs(t, m.Err(), radio.ErrRequired)
+				},
+			},
+		},
+		"required_select": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Required = true
+					m.Clear()
+					m.Validate()
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.NoError(t, m.Err())
+					assert.Equal(t, "1", m.Value(), "Value")
+				},
+			},
+		},
+		"required_other": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2"},
+				model: func(m radio.Model) radio.Model {
+					m.Required = true
+					m.Other = "Other…"
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					assert.ErrorIs(t, m.Validate(), radio.ErrRequired)
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.True(t, m.Custom())
+				},
+			},
+		},
+		"dropdown": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Dropdown = true
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.False(t, m.Expanded())
+					assert.Equal(t, "1", m.Value(), "Value")
+				},
+			},
+		},
+		"dropdown_open": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Dropdown = true
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.True(t, m.Expanded())
+					assert.Equal(t, "2", m.Value(), "Value")
+				},
+			},
+		},
+		"dropdown_confirm": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Dropdown = true
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.False(t, m.Expanded())
+					assert.Equal(t, "2", m.Value(), "Value")
+				},
+			},
+		},
+		"dropdown_escape": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Dropdown = true
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.False(t, m.Expanded())
+					assert.Equal(t, "1", m.Value(), "Value")
+				},
+			},
+		},
+		"dropdown_blur": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Dropdown = true
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+					m.Blur()
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.False(t, m.Expanded())
+					assert.Equal(t, "2", m.Value(), "Value")
+				},
+			},
+		},
+		"vim": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(uitest.KeyPress('j'))
+					m, _ = m.Update(uitest.KeyPress('j'))
+					m, _ = m.Update(uitest.KeyPress('k'))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 1, m.Index(), "Index")
+					assert.Equal(t, "2", m.Value(), "Value")
+				},
+			},
+		},
+		"last": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnd})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 4, m.Index(), "Index")
+					assert.Equal(t, "5", m.Value(), "Value")
+				},
+			},
+		},
+		"first": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(uitest.KeyPress('G'))
+					m, _ = m.Update(uitest.KeyPress('g'))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 0, m.Index(), "Index")
+					assert.Equal(t, "1", m.Value(), "Value")
+				},
+			},
+		},
+		"keymap": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				model: func(m radio.Model) radio.Model {
+					m.KeyMap.Down = key.NewBinding(key.WithKeys("n"))
+					m.Focus()
+					m, _ = m.Update(uitest.KeyPress('n'))
+					m, _ = m.Update(uitest.KeyPress('j'))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 1, m.Index(), "Index")
+					assert.Equal(t, "2", m.Value(), "Value")
+				},
+			},
+		},
+		"type_ahead_keymap": {
+			args: args{
+				heading: "test",
+				values:  []string{"Apple", "Grape", "Kiwi"},
+				model: func(m radio.Model) radio.Model {
+					m.TypeAhead = true
+					m.Focus()
+					m, _ = m.Update(uitest.KeyPress('k'))
+					m, _ = m.Update(uitest.KeyPress('g'))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 1, m.Index(), "Index")
+					assert.Equal(t, "Grape", m.Value(), "Value")
+				},
+			},
+		},
+		"help": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5"},
+				layout:  radio.Horizontal,
+				model: func(m radio.Model) radio.Model {
+					m.Dropdown = true
+
+					assert.Equal(t, []key.Binding{
+						m.KeyMap.Up, m.KeyMap.Down, m.KeyMap.Left, m.KeyMap.Right, m.KeyMap.Toggle,
+					}, m.ShortHelp())
+					assert.Len(t, m.FullHelp(), 3)
+
+					m.Dropdown = false
+					m.Layout = radio.Vertical
+
+					assert.Equal(t, []key.Binding{m.KeyMap.Up, m.KeyMap.Down}, m.ShortHelp())
+					assert.Len(t, m.FullHelp(), 2)
+
+					return m
+				},
+			},
+		},
+		"click": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.MouseMsg{X: 2, Y: 3, Type: tea.MouseLeft})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 2, m.Index(), "Index")
+					assert.Equal(t, "3", m.Value(), "Value")
+				},
+			},
+		},
+		"click_position": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m.X = 4
+					m.Y = 5
+					m, _ = m.Update(tea.MouseMsg{X: 6, Y: 8, Type: tea.MouseLeft})
+					m, _ = m.Update(tea.MouseMsg{X: 1, Y: 9, Type: tea.MouseLeft})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 2, m.Index(), "Index")
+					assert.Equal(t, "3", m.Value(), "Value")
+				},
+			},
+		},
+		"click_heading": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.MouseMsg{X: 2, Y: 0, Type: tea.MouseLeft})
+					m, _ = m.Update(tea.MouseMsg{X: 20, Y: 3, Type: tea.MouseLeft})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 0, m.Index(), "Index")
+					assert.Equal(t, "1", m.Value(), "Value")
+				},
+			},
+		},
+		"click_grid": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+				layout:  radio.Grid,
+				columns: 3,
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.MouseMsg{X: 6, Y: 2, Type: tea.MouseLeft})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 4, m.Index(), "Index")
+					assert.Equal(t, "5", m.Value(), "Value")
+				},
+			},
+		},
+		"click_viewport": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+				height:  5,
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m.Select("8")
+					m, _ = m.Update(tea.MouseMsg{X: 2, Y: 1, Type: tea.MouseLeft})
+					m, _ = m.Update(tea.MouseMsg{X: 2, Y: 2, Type: tea.MouseLeft})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 5, m.Index(), "Index")
+					assert.Equal(t, "6", m.Value(), "Value")
+				},
+			},
+		},
+		"wheel": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m, _ = m.Update(tea.MouseMsg{X: 0, Y: 0, Type: tea.MouseWheelDown})
+					m, _ = m.Update(tea.MouseMsg{X: 0, Y: 0, Type: tea.MouseWheelDown})
+					m, _ = m.Update(tea.MouseMsg{X: 0, Y: 0, Type: tea.MouseWheelUp})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 1, m.Index(), "Index")
+					assert.Equal(t, "2", m.Value(), "Value")
+				},
+			},
+		},
+		"click_dropdown": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+				model: func(m radio.Model) radio.Model {
+					m.Focus()
+					m.Dropdown = true
+					m, _ = m.Update(tea.MouseMsg{X: 2, Y: 0, Type: tea.MouseLeft})
+
+					assert.True(t, m.Expanded())
+
+					m, _ = m.Update(tea.MouseMsg{X: 2, Y: 2, Type: tea.MouseLeft})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.False(t, m.Expanded())
+					assert.Equal(t, 1, m.Index(), "Index")
+					assert.Equal(t, "2", m.Value(), "Value")
+				},
+			},
+		},
+		"click_blur": {
+			args: args{
+				heading: "test",
+				values:  []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+				model: func(m radio.Model) radio.Model {
+					m, _ = m.Update(tea.MouseMsg{X: 2, Y: 3, Type: tea.MouseLeft})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 0, m.Index(), "Index")
+					assert.Equal(t, "1", m.Value(), "Value")
+				},
+			},
+		},
+		"segmented": {
+			args: args{
+				heading: "test",
+				values:  []string{"Day", "Week", "Month"},
+				model: func(m radio.Model) radio.Model {
+					m.Segmented = true
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 1, m.Index(), "Index")
+					assert.Equal(t, "Week", m.Value(), "Value")
+				},
+			},
+		},
+		"segmented_fill": {
+			args: args{
+				heading: "test",
+				values:  []string{"Day", "Week", "Month"},
+				width:   30,
+				model: func(m radio.Model) radio.Model {
+					m.Segmented = true
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 0, m.Index(), "Index")
+					assert.Equal(t, "Day", m.Value(), "Value")
+				},
+			},
+		},
+		"segmented_truncate": {
+			args: args{
+				heading: "test",
+				values:  []string{"Yesterday", "Today", "Tomorrow"},
+				width:   20,
+				model: func(m radio.Model) radio.Model {
+					m.Segmented = true
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 0, m.Index(), "Index")
+					assert.Equal(t, "Yesterday", m.Value(), "Value")
+				},
+			},
+		},
+		"segmented_narrow": {
+			args: args{
+				heading: "test",
+				values:  []string{"Small", "Medium", "Large"},
+				width:   4,
+				model: func(m radio.Model) radio.Model {
+					m.Segmented = true
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					for _, l := range strings.Split(m.View(), "\n") {
+						assert.LessOrEqual(t, lipgloss.Width(l), 4)
+					}
+				},
+			},
+		},
+		"segmented_click": {
+			args: args{
+				heading: "test",
+				values:  []string{"Day", "Week", "Month"},
+				model: func(m radio.Model) radio.Model {
+					m.Segmented = true
+					m.Focus()
+					m, _ = m.Update(tea.MouseMsg{X: 10, Y: 1, Type: tea.MouseLeft})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Model) {
+					assert.Equal(t, 1, m.Index(), "Index")
+					assert.Equal(t, "Week", m.Value(), "Value")
+				},
+			},
+		},
 		"focus": {
 			args: args{
 				values: []string{"1", "2", "3", "4", "5"},
@@ -266,6 +1415,244 @@ func Test(t *testing.T) {
 			m.Heading = tt.args.heading
 			m.Values = tt.args.values
 			m.Loop = tt.args.loop
+			m.Layout = tt.args.layout
+			m.Columns = tt.args.columns
+			m.Width = tt.args.width
+			m.Height = tt.args.height
+
+			if tt.args.model != nil {
+				m = tt.args.model(m)
+			}
+
+			if tt.want.model != nil {
+				tt.want.model(m)
+			}
+
+			v := uitest.StripString(m.View())
+			autogold.ExpectFile(t, autogold.Raw(v), autogold.Name(name))
+		})
+	}
+}
+
+func TestTyped(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		heading string
+		options []radio.Option[int]
+		inline  bool
+		layout  radio.Layout
+		model   func(m radio.Typed[int]) radio.Typed[int]
+	}
+
+	type want struct {
+		model func(m radio.Typed[int])
+	}
+
+	options := []radio.Option[int]{
+		{Value: 10, Label: "Small", Description: "Up to 10 users"},
+		{Value: 50, Label: "Medium", Description: "Up to 50 users", Disabled: true},
+		{Value: 100, Label: "Large", Description: "Up to 100 users"},
+	}
+
+	tests := map[string]struct {
+		name string
+		args args
+		want want
+	}{
+		"typed_default": {
+			args: args{
+				heading: "test",
+				options: options,
+			},
+			want: want{
+				model: func(m radio.Typed[int]) {
+					assert.Equal(t, 0, m.Index(), "Index")
+					assert.Equal(t, 10, m.Selected(), "Selected")
+					assert.Equal(t, "Small", m.Value(), "Value")
+				},
+			},
+		},
+		"typed_empty": {
+			want: want{
+				model: func(m radio.Typed[int]) {
+					assert.Zero(t, m.Selected())
+				},
+			},
+		},
+		"typed_values": {
+			args: args{
+				heading: "test",
+				options: options,
+				model: func(m radio.Typed[int]) radio.Typed[int] {
+					m.Values()[0] = "Tiny"
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Typed[int]) {
+					assert.Equal(t, []string{"Small", "Medium", "Large"}, m.Values(), "Values")
+					assert.Equal(t, "Small", m.Value(), "Value")
+				},
+			},
+		},
+		"typed_inline": {
+			args: args{
+				heading: "test",
+				options: options,
+				inline:  true,
+			},
+			want: want{
+				model: func(m radio.Typed[int]) {
+					assert.Equal(t, 10, m.Selected(), "Selected")
+				},
+			},
+		},
+		"typed_disabled": {
+			args: args{
+				heading: "test",
+				options: options,
+				model: func(m radio.Typed[int]) radio.Typed[int] {
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Typed[int]) {
+					assert.True(t, m.Disabled(1))
+					assert.Equal(t, 2, m.Index(), "Index")
+					assert.Equal(t, 100, m.Selected(), "Selected")
+				},
+			},
+		},
+		"typed_unselected": {
+			args: args{
+				heading: "test",
+				options: options,
+				model: func(m radio.Typed[int]) radio.Typed[int] {
+					m.Unselected = true
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Typed[int]) {
+					assert.Equal(t, -1, m.Index(), "Index")
+					assert.Zero(t, m.Selected(), "Selected")
+					assert.False(t, m.HasValue(), "HasValue")
+				},
+			},
+		},
+		"typed_disabled_all": {
+			args: args{
+				heading: "test",
+				options: []radio.Option[int]{
+					{Value: 1, Label: "One", Disabled: true},
+					{Value: 2, Label: "Two", Disabled: true},
+				},
+			},
+			want: want{
+				model: func(m radio.Typed[int]) {
+					assert.Equal(t, -1, m.Index(), "Index")
+					assert.Zero(t, m.Selected(), "Selected")
+					assert.False(t, m.HasValue(), "HasValue")
+				},
+			},
+		},
+		"typed_disabled_first": {
+			args: args{
+				heading: "test",
+				options: []radio.Option[int]{
+					{Value: 1, Label: "One", Disabled: true},
+					{Value: 2, Label: "Two"},
+				},
+				model: func(m radio.Typed[int]) radio.Typed[int] {
+					m.Previous()
+					m.Select("One")
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Typed[int]) {
+					assert.Equal(t, 1, m.Index(), "Index")
+					assert.Equal(t, 2, m.Selected(), "Selected")
+				},
+			},
+		},
+		"typed_height": {
+			args: args{
+				heading: "test",
+				options: options,
+				model: func(m radio.Typed[int]) radio.Typed[int] {
+					m.Height = 4
+					m.Next()
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Typed[int]) {
+					assert.Equal(t, 100, m.Selected(), "Selected")
+				},
+			},
+		},
+		"typed_accelerator": {
+			args: args{
+				heading: "test",
+				options: []radio.Option[int]{
+					{Value: 10, Label: "Small", Key: "s"},
+					{Value: 50, Label: "Medium", Key: "m", Disabled: true},
+					{Value: 100, Label: "Large", Key: "l"},
+				},
+				model: func(m radio.Typed[int]) radio.Typed[int] {
+					m.Focus()
+					m, _ = m.Update(uitest.KeyPress('l'))
+					m, _ = m.Update(uitest.KeyPress('m'))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Typed[int]) {
+					assert.Equal(t, 100, m.Selected(), "Selected")
+				},
+			},
+		},
+		"typed_horizontal": {
+			args: args{
+				heading: "test",
+				options: options,
+				layout:  radio.Horizontal,
+				model: func(m radio.Typed[int]) radio.Typed[int] {
+					m.Focus()
+					m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m radio.Typed[int]) {
+					assert.Equal(t, 100, m.Selected(), "Selected")
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		name := name
+		tt := tt
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := radio.NewTyped(tt.args.options...)
+			m.Heading = tt.args.heading
+			m.InlineDescriptions = tt.args.inline
+			m.Layout = tt.args.layout
 
 			if tt.args.model != nil {
 				m = tt.args.model(m)
@@ -280,3 +1667,23 @@ func Test(t *testing.T) {
 		})
 	}
 }
+
+func cmdMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+
+	msg := cmd()
+
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+
+	var msgs []tea.Msg
+	for _, c := range batch {
+		msgs = append(msgs, cmdMsgs(c)...)
+	}
+
+	return msgs
+}
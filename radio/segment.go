@@ -0,0 +1,113 @@
+package radio
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+)
+
+const (
+	// Minimum width of a segment label.
+	minSegmentLabel = 1
+
+	// Padding either side of a segment label.
+	segmentPadding = 1
+)
+
+// inline returns whether the values are placed on the same line.
+func (m Model) inline() bool {
+	return m.Layout != Vertical || m.Segmented
+}
+
+// renderSegments renders each value as a segment. Segments fill the
+// width when set, truncating the labels that do not fit.
+func (m Model) renderSegments() []string {
+	values := m.values()
+	widths := m.segmentWidths(values)
+	segments := make([]string, len(values))
+
+	for idx, val := range values {
+		style := m.Styles.Segment
+		if m.Disabled(idx) {
+			style = m.Styles.Disabled
+		}
+
+		if idx == m.selected() {
+			style = m.Styles.SegmentActive
+		}
+
+		label := val
+		if inner := widths[idx] - segmentPadding*2; lipgloss.Width(val) > inner {
+			label = truncate.StringWithTail(val, uint(inner), "…")
+		}
+
+		segments[idx] = style.Copy().
+			Width(widths[idx]).
+			Align(lipgloss.Center).
+			Padding(0, segmentPadding).
+			Render(label)
+	}
+
+	return segments
+}
+
+// segmentWidths is the width of each segment including padding. The
+// width is shared equally between the segments when set.
+func (m Model) segmentWidths(values []string) []int {
+	widths := make([]int, len(values))
+
+	if m.Width < 1 {
+		for idx, val := range values {
+			widths[idx] = lipgloss.Width(val) + segmentPadding*2
+		}
+
+		return widths
+	}
+
+	// The brackets and separators use one column each.
+	fill := m.Width - len(values) - 1
+
+	for idx := range values {
+		w := fill / len(values)
+		if idx < fill%len(values) {
+			w++
+		}
+
+		widths[idx] = max(w, minSegmentLabel+segmentPadding*2)
+	}
+
+	return widths
+}
+
+// segmentCells positions the segments after the opening bracket and
+// the separator of the previous segment.
+func segmentCells(segments []string) []cell {
+	cs := make([]cell, len(segments))
+	x := 1
+
+	for idx, s := range segments {
+		w := lipgloss.Width(s)
+		cs[idx] = cell{index: idx, x: x, width: w}
+		x += w + 1
+	}
+
+	return cs
+}
+
+// renderBar joins the segments between brackets with separators. The
+// bar is truncated when the width is too narrow for the segments at
+// their minimum width.
+func (m Model) renderBar(segments []string) string {
+	sep := m.Styles.SegmentSeparator.String()
+
+	bar := m.Styles.SegmentLeft.String() +
+		strings.Join(segments, sep) +
+		m.Styles.SegmentRight.String()
+
+	if m.Width > 0 && lipgloss.Width(bar) > m.Width {
+		bar = truncate.StringWithTail(bar, uint(m.Width), "…")
+	}
+
+	return bar
+}
@@ -5,16 +5,36 @@ import (
 )
 
 const (
-	Inactive = "○"
-	Active   = "●"
+	Inactive         = "○"
+	Active           = "●"
+	OverflowAbove    = "↑"
+	OverflowBelow    = "↓"
+	DropdownClosed   = "▾"
+	DropdownOpen     = "▴"
+	SegmentLeft      = "["
+	SegmentRight     = "]"
+	SegmentSeparator = "|"
 )
 
 type Styles struct {
-	HeadingActive   lipgloss.Style
-	HeadingInactive lipgloss.Style
-	Values          lipgloss.Style
-	Active          lipgloss.Style
-	Inactive        lipgloss.Style
+	HeadingActive    lipgloss.Style
+	HeadingInactive  lipgloss.Style
+	Values           lipgloss.Style
+	Active           lipgloss.Style
+	Inactive         lipgloss.Style
+	Description      lipgloss.Style
+	Disabled         lipgloss.Style
+	OverflowAbove    lipgloss.Style
+	OverflowBelow    lipgloss.Style
+	Accelerator      lipgloss.Style
+	Error            lipgloss.Style
+	DropdownClosed   lipgloss.Style
+	DropdownOpen     lipgloss.Style
+	Segment          lipgloss.Style
+	SegmentActive    lipgloss.Style
+	SegmentLeft      lipgloss.Style
+	SegmentRight     lipgloss.Style
+	SegmentSeparator lipgloss.Style
 }
 
 func defaultStyles() Styles {
@@ -25,6 +45,19 @@ func defaultStyles() Styles {
 	s.Active = lipgloss.NewStyle().SetString(Active)
 	s.Inactive = lipgloss.NewStyle().SetString(Inactive)
 	s.Values = lipgloss.NewStyle()
+	s.Description = lipgloss.NewStyle().Faint(true)
+	s.Disabled = lipgloss.NewStyle().Faint(true)
+	s.OverflowAbove = lipgloss.NewStyle().Faint(true).SetString(OverflowAbove)
+	s.OverflowBelow = lipgloss.NewStyle().Faint(true).SetString(OverflowBelow)
+	s.Accelerator = lipgloss.NewStyle().Faint(true)
+	s.Error = lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+	s.DropdownClosed = lipgloss.NewStyle().SetString(DropdownClosed)
+	s.DropdownOpen = lipgloss.NewStyle().SetString(DropdownOpen)
+	s.Segment = lipgloss.NewStyle()
+	s.SegmentActive = lipgloss.NewStyle().Background(lipgloss.Color("4")).Foreground(lipgloss.Color("15"))
+	s.SegmentLeft = lipgloss.NewStyle().SetString(SegmentLeft)
+	s.SegmentRight = lipgloss.NewStyle().SetString(SegmentRight)
+	s.SegmentSeparator = lipgloss.NewStyle().SetString(SegmentSeparator)
 
 	return s
 }
@@ -0,0 +1,75 @@
+package radio
+
+import (
+	"errors"
+)
+
+// ErrRequired is the error when a value is required and no value
+// is selected.
+var ErrRequired = errors.New("a value is required")
+
+// unselected is the index when no value is selected.
+const unselected = -1
+
+// Clear removes the selection so that no value is selected.
+func (m *Model) Clear() {
+	m.choose(unselected)
+	m.typed = ""
+	m.scroll()
+}
+
+// HasValue returns whether a value is selected. The other option
+// only has a value when text has been entered.
+func (m Model) HasValue() bool {
+	if m.Custom() {
+		return m.input.Value() != ""
+	}
+
+	idx := m.selected()
+
+	return idx >= 0 && idx < len(m.values())
+}
+
+// Validate checks that a value is selected when required. The error
+// is shown below the heading until a value is selected.
+func (m *Model) Validate() error {
+	m.err = nil
+
+	if m.Required && !m.HasValue() {
+		m.err = ErrRequired
+	}
+
+	return m.err
+}
+
+// Err is the error of the last validation.
+func (m Model) Err() error {
+	return m.err
+}
+
+// selected is the index of the selected value. No value is selected
+// when starting unselected until a value is chosen.
+func (m Model) selected() int {
+	if m.Unselected && !m.chosen {
+		return unselected
+	}
+
+	return m.index
+}
+
+// choose selects the index.
+func (m *Model) choose(idx int) {
+	m.index = idx
+	m.chosen = true
+}
+
+// selectedRow is the row of the selected value or the first row when
+// no value is selected.
+func (m Model) selectedRow(cs []cell) int {
+	idx := m.selected()
+	if idx < 0 || idx >= len(cs) {
+		return 0
+	}
+
+	return cs[idx].row
+}
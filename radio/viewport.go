@@ -0,0 +1,132 @@
+package radio
+
+import (
+	"strings"
+)
+
+// viewport returns the rows shown within the height with indicators
+// when rows are hidden above or below.
+func (m Model) viewport(rows []string, selected int) []string {
+	if m.Height < 1 {
+		return rows
+	}
+
+	heights := rowHeights(rows)
+	start, end := m.rowRange(heights, selected)
+
+	// Indicators are dropped when the rows shown leave no space
+	// for them, the indicator above first.
+	space := m.Height
+	for _, h := range heights[start:end] {
+		space -= h
+	}
+
+	below := end < len(rows) && space > 0
+	if below {
+		space--
+	}
+
+	above := start > 0 && space > 0
+
+	var view []string
+
+	if above {
+		view = append(view, m.Styles.OverflowAbove.String())
+	}
+
+	view = append(view, rows[start:end]...)
+
+	if below {
+		view = append(view, m.Styles.OverflowBelow.String())
+	}
+
+	return view
+}
+
+// rowRange returns the range of rows shown.
+func (m Model) rowRange(heights []int, selected int) (int, int) {
+	if m.Height < 1 {
+		return 0, len(heights)
+	}
+
+	return window(heights, m.viewOffset(heights, selected), m.Height)
+}
+
+// viewOffset is the first row shown, scrolled the least amount
+// needed for the selected row to be visible.
+func (m Model) viewOffset(heights []int, selected int) int {
+	offset := m.offset
+	if offset >= len(heights) {
+		offset = len(heights) - 1
+	}
+
+	if selected < offset {
+		return selected
+	}
+
+	for offset < selected {
+		if _, end := window(heights, offset, m.Height); selected < end {
+			break
+		}
+
+		offset++
+	}
+
+	return max(offset, 0)
+}
+
+// scroll stores the offset of the viewport so that the view
+// only scrolls when the selected row would be hidden.
+func (m *Model) scroll() {
+	if m.Height < 1 || len(m.values()) == 0 {
+		m.offset = 0
+
+		return
+	}
+
+	values := m.renderValues()
+	cs := m.cells(values)
+	heights := rowHeights(renderCells(values, cs))
+
+	m.offset = m.viewOffset(heights, m.selectedRow(cs))
+}
+
+// window returns the range of rows that fit within the height from
+// the offset. A line is reserved for each overflow indicator and at
+// least one row is always shown.
+func window(heights []int, offset, height int) (int, int) {
+	available := height
+	if offset > 0 {
+		available--
+	}
+
+	end := fit(heights, offset, available)
+	if end < len(heights) {
+		end = fit(heights, offset, available-1)
+	}
+
+	return offset, max(end, offset+1)
+}
+
+// fit returns the end of the rows from the offset that fit within
+// the height.
+func fit(heights []int, offset, height int) int {
+	end := offset
+
+	for end < len(heights) && heights[end] <= height {
+		height -= heights[end]
+		end++
+	}
+
+	return end
+}
+
+// rowHeights is the number of lines of each row.
+func rowHeights(rows []string) []int {
+	heights := make([]int, len(rows))
+	for idx, r := range rows {
+		heights[idx] = strings.Count(r, "\n") + 1
+	}
+
+	return heights
+}
@@ -0,0 +1,88 @@
+package toggle
+
+import (
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// FrameMsg moves the switch knob one position while animating.
+type FrameMsg struct {
+	ID  int
+	tag int
+}
+
+// frameDuration is the time between each frame of the animation.
+const frameDuration = 40 * time.Millisecond
+
+var lastID int64
+
+// nextID returns a unique identifier for each toggle so that the
+// frames are only received by the toggle that is animating.
+func nextID() int {
+	return int(atomic.AddInt64(&lastID, 1))
+}
+
+// ID is the unique identifier of the toggle.
+func (m Model) ID() int {
+	return m.id
+}
+
+// Animating returns whether the switch knob is moving.
+func (m Model) Animating() bool {
+	return m.animating
+}
+
+// animate starts moving the switch knob from the position before the
+// state changed. Only the switch appearance is animated.
+func (m *Model) animate(from int) tea.Cmd {
+	if !m.Animate || m.Appearance != Switch || from == m.knobTarget() {
+		return nil
+	}
+
+	m.animating = true
+	m.position = from
+	m.tag++
+
+	return m.frame()
+}
+
+// updateFrame moves the knob towards the position of the state and
+// stops animating once it is reached. Frames from other toggles or
+// earlier animations are discarded.
+func (m *Model) updateFrame(msg FrameMsg) tea.Cmd {
+	if msg.ID != m.id || msg.tag != m.tag || !m.animating {
+		return nil
+	}
+
+	target := m.knobTarget()
+
+	switch {
+	case m.position < target:
+		m.position++
+	case m.position > target:
+		m.position--
+	}
+
+	if m.position == target {
+		m.animating = false
+
+		return nil
+	}
+
+	return m.frame()
+}
+
+// frame returns a command that sends the next frame.
+func (m Model) frame() tea.Cmd {
+	id := m.id
+	tag := m.tag
+
+	return tea.Tick(frameDuration, func(time.Time) tea.Msg {
+		return FrameMsg{
+			ID:  id,
+			tag: tag,
+		}
+	})
+}
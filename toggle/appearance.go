@@ -0,0 +1,147 @@
+package toggle
+
+import (
+	"strings"
+)
+
+// Appearance is the rendering of the toggle state.
+type Appearance int
+
+const (
+	// Checkbox renders the state as a box glyph.
+	Checkbox Appearance = iota
+
+	// Switch renders the state as a sliding switch with a label.
+	Switch
+
+	// Pill renders the state as an ON or OFF label.
+	Pill
+)
+
+// Placement is the position of the text relative to the state.
+type Placement int
+
+const (
+	// LabelRight places the text after the state.
+	LabelRight Placement = iota
+
+	// LabelLeft places the text before the state.
+	LabelLeft
+)
+
+const (
+	// Width of the switch track between the brackets.
+	defaultSwitchWidth = 4
+
+	// Smallest width of the switch track with the knob off and on
+	// at different positions.
+	minSwitchWidth = 4
+)
+
+// renderState renders the state with the appearance of the toggle.
+func (m Model) renderState() string {
+	switch m.Appearance {
+	case Switch:
+		return m.renderSwitch()
+	case Pill:
+		return m.renderPill()
+	}
+
+	switch m.Value() {
+	case On:
+		return m.Styles.Active.String()
+	case Indeterminate:
+		return m.Styles.Mixed.String()
+	default:
+		return m.Styles.Inactive.String()
+	}
+}
+
+// renderSwitch renders the switch track with the knob at its position
+// followed by the state label.
+func (m Model) renderSwitch() string {
+	width := m.switchWidth()
+	pos := m.knob()
+
+	knob := m.Styles.Knob.String()
+	label := m.Styles.SwitchOff.String()
+
+	switch m.Value() {
+	case On:
+		label = m.Styles.SwitchOn.String()
+	case Indeterminate:
+		knob = m.Styles.KnobMixed.String()
+		label = m.Styles.SwitchMixed.String()
+	}
+
+	track := strings.Repeat(" ", pos) + knob + strings.Repeat(" ", width-pos-1)
+
+	return m.Styles.Track.Render("(") + track + m.Styles.Track.Render(")") + " " + label
+}
+
+// renderPill renders the state label as a pill.
+func (m Model) renderPill() string {
+	switch m.Value() {
+	case On:
+		return m.Styles.PillOn.String()
+	case Indeterminate:
+		return m.Styles.PillMixed.String()
+	default:
+		return m.Styles.PillOff.String()
+	}
+}
+
+// knob is the position of the knob in the switch track. The knob
+// is between the positions while animating, kept within the track
+// when the width changes during the animation.
+func (m Model) knob() int {
+	if m.animating {
+		return min(max(m.position, 0), m.switchWidth()-1)
+	}
+
+	return m.knobTarget()
+}
+
+// knobTarget is the position of the knob for the state. The knob
+// is one space from the side of the track.
+func (m Model) knobTarget() int {
+	off := 1
+	on := m.switchWidth() - 2
+
+	switch m.Value() {
+	case On:
+		return on
+	case Indeterminate:
+		return (off + on) / 2
+	default:
+		return off
+	}
+}
+
+// switchWidth is the width of the switch track.
+func (m Model) switchWidth() int {
+	switch {
+	case m.SwitchWidth == 0:
+		return defaultSwitchWidth
+	case m.SwitchWidth < minSwitchWidth:
+		return minSwitchWidth
+	default:
+		return m.SwitchWidth
+	}
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,282 @@
+package toggle
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Group is the Bubble Tea model for a group of Toggle widgets
+// that is focused as a single widget.
+type Group struct {
+	// Heading is printed on top of the group.
+	Heading string
+
+	// Items are the toggles of the group.
+	Items []Model
+
+	// Min is the least number of items that must be checked.
+	Min int
+
+	// Max is the most number of items that can be checked.
+	// Zero is unlimited.
+	Max int
+
+	// KeyMap is the key bindings of the group.
+	KeyMap GroupKeyMap
+
+	// Styles all the group components.
+	Styles GroupStyles
+
+	// Cursor is the index of the highlighted item.
+	cursor int
+
+	// Focus is the state that determines whether
+	// keyboard inputs should be accepted.
+	focus bool
+}
+
+// GroupKeyMap is the key bindings of the group.
+type GroupKeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Toggle key.Binding
+	All    key.Binding
+	None   key.Binding
+}
+
+// DefaultGroupKeyMap returns the default key bindings of the group.
+func DefaultGroupKeyMap() GroupKeyMap {
+	return GroupKeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		Toggle: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "toggle"),
+		),
+		All: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "select all"),
+		),
+		None: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "select none"),
+		),
+	}
+}
+
+// NewGroup creates a new group with a toggle for each item.
+func NewGroup(items ...string) Group {
+	g := Group{
+		KeyMap: DefaultGroupKeyMap(),
+		Styles: defaultGroupStyles(),
+	}
+
+	for _, i := range items {
+		m := New()
+		m.Text = i
+
+		g.Items = append(g.Items, m)
+	}
+
+	return g
+}
+
+// Update is the Bubble Tea update loop.
+func (g Group) Update(msg tea.Msg) (Group, tea.Cmd) {
+	// Animation frames are received by the item that is animating
+	// regardless of focus.
+	if msg, ok := msg.(FrameMsg); ok {
+		return g, g.updateFrame(msg)
+	}
+
+	if g.focus {
+		//nolint:gocritic
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			switch {
+			case key.Matches(msg, g.KeyMap.Up):
+				g.Previous()
+			case key.Matches(msg, g.KeyMap.Down):
+				g.Next()
+			case key.Matches(msg, g.KeyMap.Toggle):
+				if item := g.toggleable(); item != nil {
+					from := item.knob()
+					item.Toggle()
+
+					return g, item.animate(from)
+				}
+			case key.Matches(msg, g.KeyMap.All):
+				g.SelectAll()
+			case key.Matches(msg, g.KeyMap.None):
+				g.SelectNone()
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// View is the Bubble Text text renderer.
+func (g Group) View() string {
+	return g.renderGroup()
+}
+
+// Next moves the cursor to the next item.
+func (g *Group) Next() {
+	if g.cursor < len(g.Items)-1 {
+		g.cursor++
+	}
+}
+
+// Previous moves the cursor to the previous item.
+func (g *Group) Previous() {
+	if g.cursor > 0 {
+		g.cursor--
+	}
+}
+
+// Cursor is the index of the highlighted item.
+func (g Group) Cursor() int {
+	return g.cursor
+}
+
+// Toggle switches the state of the highlighted item unless
+// it would break the min or max constraint.
+func (g *Group) Toggle() {
+	if item := g.toggleable(); item != nil {
+		item.Toggle()
+	}
+}
+
+// toggleable returns the highlighted item if its state can be
+// switched without breaking the min or max constraint.
+func (g *Group) toggleable() *Model {
+	if g.cursor >= len(g.Items) {
+		return nil
+	}
+
+	item := &g.Items[g.cursor]
+	checked := len(g.Checked())
+
+	switch {
+	// Unchecking would leave too few items checked.
+	case item.Value() == On && checked <= g.Min:
+		return nil
+	// Checking would leave too many items checked.
+	case item.Value() != On && g.Max > 0 && checked >= g.Max:
+		return nil
+	}
+
+	return item
+}
+
+// updateFrame passes the animation frame to the item it belongs to.
+func (g *Group) updateFrame(msg FrameMsg) tea.Cmd {
+	for idx := range g.Items {
+		if g.Items[idx].ID() == msg.ID {
+			return g.Items[idx].updateFrame(msg)
+		}
+	}
+
+	return nil
+}
+
+// SelectAll checks the items in order until the max is reached.
+func (g *Group) SelectAll() {
+	checked := len(g.Checked())
+
+	for idx := range g.Items {
+		if g.Max > 0 && checked >= g.Max {
+			return
+		}
+
+		if g.Items[idx].Value() != On {
+			g.Items[idx].SetValue(On)
+			checked++
+		}
+	}
+}
+
+// SelectNone unchecks the items from last to first until the
+// min is reached.
+func (g *Group) SelectNone() {
+	checked := len(g.Checked())
+
+	for idx := len(g.Items) - 1; idx >= 0; idx-- {
+		if checked <= g.Min {
+			return
+		}
+
+		if g.Items[idx].Value() == On {
+			g.Items[idx].SetValue(Off)
+			checked--
+		}
+	}
+}
+
+// Checked returns the text of the checked items.
+func (g Group) Checked() []string {
+	var checked []string
+
+	for _, i := range g.Items {
+		if i.Value() == On {
+			checked = append(checked, i.text())
+		}
+	}
+
+	return checked
+}
+
+// renderGroup renders the group widget.
+func (g Group) renderGroup() string {
+	var arr []string
+
+	// If empty heading, do not render it.
+	if g.Heading != "" {
+		heading := g.Styles.HeadingInactive.Render(g.Heading)
+		// Use a highlighted header if component has focus.
+		if g.focus {
+			heading = g.Styles.HeadingActive.Render(g.Heading)
+		}
+
+		arr = append(arr, heading)
+	}
+
+	for idx, i := range g.Items {
+		// The cursor is only shown when the group has focus.
+		cursor := strings.Repeat(" ", lipgloss.Width(g.Styles.Cursor.String()))
+		if idx == g.cursor && g.focus {
+			cursor = g.Styles.Cursor.String()
+		}
+
+		arr = append(arr, cursor+" "+i.View())
+	}
+
+	return strings.Join(arr, "\n")
+}
+
+// Focused return the focus state of the group.
+func (g Group) Focused() bool {
+	return g.focus
+}
+
+// Focus sets the focus state of the group. When the group
+// is in focus it can receive keyboard input.
+func (g *Group) Focus() {
+	g.focus = true
+}
+
+// Blur removes the focus state of the group. When the group
+// is blurred it cannot receive keyboard input.
+func (g *Group) Blur() {
+	g.focus = false
+}
@@ -7,6 +7,14 @@ import (
 const (
 	Inactive = "▢"
 	Active   = "▣"
+	Mixed    = "▤"
+	Cursor   = "❯"
+
+	Knob      = "●"
+	KnobMixed = "◐"
+	TextOn    = "ON"
+	TextOff   = "OFF"
+	TextMixed = "–"
 )
 
 type Styles struct {
@@ -15,6 +23,22 @@ type Styles struct {
 	Text            lipgloss.Style
 	Active          lipgloss.Style
 	Inactive        lipgloss.Style
+	Mixed           lipgloss.Style
+	Knob            lipgloss.Style
+	KnobMixed       lipgloss.Style
+	Track           lipgloss.Style
+	SwitchOn        lipgloss.Style
+	SwitchOff       lipgloss.Style
+	SwitchMixed     lipgloss.Style
+	PillOn          lipgloss.Style
+	PillOff         lipgloss.Style
+	PillMixed       lipgloss.Style
+}
+
+type GroupStyles struct {
+	HeadingActive   lipgloss.Style
+	HeadingInactive lipgloss.Style
+	Cursor          lipgloss.Style
 }
 
 func defaultStyles() Styles {
@@ -32,8 +56,42 @@ func defaultStyles() Styles {
 	// Disabled toggle switch.
 	s.Inactive = lipgloss.NewStyle().SetString(Inactive)
 
+	// Indeterminate tri-state toggle switch.
+	s.Mixed = lipgloss.NewStyle().SetString(Mixed)
+
 	// Style of switch text.
 	s.Text = lipgloss.NewStyle()
 
+	// Switch knob and track.
+	s.Knob = lipgloss.NewStyle().SetString(Knob)
+	s.KnobMixed = lipgloss.NewStyle().SetString(KnobMixed)
+	s.Track = lipgloss.NewStyle()
+
+	// Switch state labels.
+	s.SwitchOn = lipgloss.NewStyle().SetString(TextOn)
+	s.SwitchOff = lipgloss.NewStyle().SetString(TextOff)
+	s.SwitchMixed = lipgloss.NewStyle().SetString(TextMixed)
+
+	// Pill state labels.
+	pill := lipgloss.NewStyle().Padding(0, 1).Foreground(lipgloss.Color("0"))
+	s.PillOn = pill.Copy().Background(lipgloss.Color("2")).SetString(TextOn)
+	s.PillOff = pill.Copy().Background(lipgloss.Color("8")).SetString(TextOff)
+	s.PillMixed = pill.Copy().Background(lipgloss.Color("3")).SetString(TextMixed)
+
+	return s
+}
+
+func defaultGroupStyles() GroupStyles {
+	var s GroupStyles
+
+	// Focused group heading.
+	s.HeadingActive = lipgloss.NewStyle().Bold(true)
+
+	// Blurred group heading.
+	s.HeadingInactive = lipgloss.NewStyle()
+
+	// Highlighted item indicator.
+	s.Cursor = lipgloss.NewStyle().SetString(Cursor)
+
 	return s
 }
@@ -12,18 +12,55 @@ type Model struct {
 	// Heading is printed on top of the Toggle switch.
 	Heading string
 
-	// State is the state of the Toggle switch.
+	// State is the state of the Toggle switch. Setting it to
+	// true takes precedence over the indeterminate state.
 	State bool
 
+	// TriState enables the indeterminate state that is
+	// neither enabled nor disabled.
+	TriState bool
+
+	// Cycle is the order of the states when toggling a
+	// tri-state toggle. Defaults to off, on, indeterminate.
+	Cycle []Value
+
 	// Styles all the widget components.
 	Styles Styles
 
 	// Text is the label for the toggle.
 	Text string
 
+	// Appearance is the rendering of the state.
+	Appearance Appearance
+
+	// LabelPosition places the text before or after the state.
+	LabelPosition Placement
+
+	// SwitchWidth is the width of the switch track.
+	// Defaults to 4, which is also the minimum.
+	SwitchWidth int
+
+	// Animate slides the switch knob when the state changes.
+	Animate bool
+
 	// Focus is the state that determines whether
 	// keyboard inputs should be accepted.
 	focus bool
+
+	// Indeterminate is the third state of a tri-state toggle.
+	indeterminate bool
+
+	// ID identifies the frames of the animation.
+	id int
+
+	// Tag identifies the current animation.
+	tag int
+
+	// Animating is whether the switch knob is moving.
+	animating bool
+
+	// Position is the position of the knob while animating.
+	position int
 }
 
 const defaultText = "Enable"
@@ -32,18 +69,32 @@ const defaultText = "Enable"
 func New() Model {
 	return Model{
 		Styles: defaultStyles(),
+		id:     nextID(),
 	}
 }
 
 // Update is the Bubble Tea update loop.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	// The indeterminate state is replaced once the toggle is on.
+	if m.State {
+		m.indeterminate = false
+	}
+
+	// Animation frames are received regardless of focus.
+	if msg, ok := msg.(FrameMsg); ok {
+		return m, m.updateFrame(msg)
+	}
+
 	if m.focus {
 		//nolint:gocritic
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
 			switch msg.String() {
 			case " ":
+				from := m.knob()
 				m.Toggle()
+
+				return m, m.animate(from)
 			}
 		}
 	}
@@ -56,9 +107,17 @@ func (m Model) View() string {
 	return m.renderToggle()
 }
 
-// Toggle switches the state.
+// Toggle switches the state. A tri-state toggle moves to the
+// next state in the cycle.
 func (m *Model) Toggle() {
+	if m.TriState {
+		m.cycle()
+
+		return
+	}
+
 	m.State = !m.State
+	m.indeterminate = false
 }
 
 // renderToggle rends the toggle widget.
@@ -76,19 +135,26 @@ func (m Model) renderToggle() string {
 		arr = append(arr, heading)
 	}
 
-	state := m.Styles.Inactive.String()
-	if m.State {
-		state = m.Styles.Active.String()
+	state := m.renderState()
+	text := m.Styles.Text.Render(m.text())
+
+	switch m.LabelPosition {
+	case LabelLeft:
+		arr = append(arr, fmt.Sprintf("%v %v", text, state))
+	default:
+		arr = append(arr, fmt.Sprintf("%v %v", state, text))
 	}
 
-	text := defaultText
+	return strings.Join(arr, "\n")
+}
+
+// text is the label of the toggle.
+func (m Model) text() string {
 	if m.Text != "" {
-		text = m.Text
+		return m.Text
 	}
 
-	arr = append(arr, fmt.Sprintf("%v %v", state, m.Styles.Text.Render(text)))
-
-	return strings.Join(arr, "\n")
+	return defaultText
 }
 
 // Focused return the focus state of the model.
@@ -89,6 +89,260 @@ func Test(t *testing.T) {
 				},
 			},
 		},
+		"tristate": {
+			args: args{
+				model: func(m toggle.Model) toggle.Model {
+					m.TriState = true
+					m.Focus()
+					m, _ = m.Update(uitest.KeyPress(' '))
+					m, _ = m.Update(uitest.KeyPress(' '))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m toggle.Model) {
+					assert.Equal(t, toggle.Indeterminate, m.Value())
+					assert.False(t, m.State)
+				},
+			},
+		},
+		"tristate_cycle": {
+			args: args{
+				model: func(m toggle.Model) toggle.Model {
+					m.TriState = true
+					m.Cycle = []toggle.Value{toggle.Indeterminate, toggle.On, toggle.Off}
+					m.SetValue(toggle.Indeterminate)
+					m.Toggle()
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m toggle.Model) {
+					assert.Equal(t, toggle.On, m.Value())
+					assert.True(t, m.State)
+				},
+			},
+		},
+		"tristate_missing": {
+			args: args{
+				state: true,
+				model: func(m toggle.Model) toggle.Model {
+					m.TriState = true
+					m.Cycle = []toggle.Value{toggle.Indeterminate, toggle.Off}
+					m.Toggle()
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m toggle.Model) {
+					assert.Equal(t, toggle.Indeterminate, m.Value())
+				},
+			},
+		},
+		"tristate_state": {
+			args: args{
+				model: func(m toggle.Model) toggle.Model {
+					m.TriState = true
+					m.SetValue(toggle.Indeterminate)
+					m.State = true
+					m, _ = m.Update(nil)
+					m.State = false
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m toggle.Model) {
+					assert.Equal(t, toggle.Off, m.Value())
+				},
+			},
+		},
+		"tristate_toggle_binary": {
+			args: args{
+				model: func(m toggle.Model) toggle.Model {
+					m.TriState = true
+					m.SetValue(toggle.Indeterminate)
+					m.TriState = false
+					m.Toggle()
+					m.Toggle()
+					m.TriState = true
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m toggle.Model) {
+					assert.Equal(t, toggle.Off, m.Value())
+				},
+			},
+		},
+		"tristate_disabled": {
+			args: args{
+				state: true,
+				model: func(m toggle.Model) toggle.Model {
+					m.SetValue(toggle.Indeterminate)
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m toggle.Model) {
+					assert.Equal(t, toggle.On, m.Value())
+					assert.Equal(t, "on", m.Value().String())
+				},
+			},
+		},
+		"switch": {
+			args: args{
+				model: func(m toggle.Model) toggle.Model {
+					m.Appearance = toggle.Switch
+
+					return m
+				},
+			},
+		},
+		"switch_on": {
+			args: args{
+				state: true,
+				model: func(m toggle.Model) toggle.Model {
+					m.Appearance = toggle.Switch
+
+					return m
+				},
+			},
+		},
+		"switch_mixed": {
+			args: args{
+				model: func(m toggle.Model) toggle.Model {
+					m.Appearance = toggle.Switch
+					m.TriState = true
+					m.SwitchWidth = 5
+					m.SetValue(toggle.Indeterminate)
+
+					return m
+				},
+			},
+		},
+		"pill": {
+			args: args{
+				state: true,
+				model: func(m toggle.Model) toggle.Model {
+					m.Appearance = toggle.Pill
+
+					return m
+				},
+			},
+		},
+		"label_left": {
+			args: args{
+				text: "text",
+				model: func(m toggle.Model) toggle.Model {
+					m.LabelPosition = toggle.LabelLeft
+
+					return m
+				},
+			},
+		},
+		"animate": {
+			args: args{
+				model: func(m toggle.Model) toggle.Model {
+					var cmd tea.Cmd
+
+					m.Appearance = toggle.Switch
+					m.SwitchWidth = 6
+					m.Animate = true
+					m.Focus()
+					m, cmd = m.Update(uitest.KeyPress(' '))
+
+					assert.True(t, m.Animating())
+					assert.Equal(t, "( ●    ) ON Enable", uitest.StripString(m.View()))
+
+					msg := cmd()
+					m, cmd = m.Update(msg)
+
+					assert.Equal(t, "(  ●   ) ON Enable", uitest.StripString(m.View()))
+
+					// Frames from an earlier animation are discarded.
+					m.Toggle()
+					m, cmd = m.Update(uitest.KeyPress(' '))
+					m, _ = m.Update(msg)
+
+					assert.Equal(t, "(  ●   ) ON Enable", uitest.StripString(m.View()))
+
+					for cmd != nil {
+						m, cmd = m.Update(cmd())
+					}
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m toggle.Model) {
+					assert.False(t, m.Animating())
+					assert.True(t, m.State)
+				},
+			},
+		},
+		"animate_resize": {
+			args: args{
+				model: func(m toggle.Model) toggle.Model {
+					var cmd tea.Cmd
+
+					m.Appearance = toggle.Switch
+					m.SwitchWidth = 12
+					m.Animate = true
+					m.Focus()
+					m, cmd = m.Update(uitest.KeyPress(' '))
+
+					for i := 0; i < 6; i++ {
+						m, cmd = m.Update(cmd())
+					}
+
+					m.SwitchWidth = 4
+
+					assert.Equal(t, "(   ●) ON Enable", uitest.StripString(m.View()))
+
+					for cmd != nil {
+						m, cmd = m.Update(cmd())
+					}
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m toggle.Model) {
+					assert.False(t, m.Animating())
+				},
+			},
+		},
+		"animate_other": {
+			args: args{
+				model: func(m toggle.Model) toggle.Model {
+					var cmd tea.Cmd
+
+					m.Appearance = toggle.Switch
+					m.Animate = true
+					m.Focus()
+					m, cmd = m.Update(uitest.KeyPress(' '))
+
+					other := toggle.New()
+					other.Appearance = toggle.Switch
+					other, _ = other.Update(cmd())
+
+					assert.False(t, other.Animating())
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m toggle.Model) {
+					assert.True(t, m.Animating())
+				},
+			},
+		},
 		"heading_inactive": {
 			args: args{
 				heading: "heading",
@@ -142,3 +396,216 @@ func Test(t *testing.T) {
 		})
 	}
 }
+
+func TestGroup(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		heading string
+		items   []string
+		min     int
+		max     int
+		model   func(g toggle.Group) toggle.Group
+	}
+
+	type want struct {
+		model func(g toggle.Group)
+	}
+
+	items := []string{"alpha", "beta", "gamma", "delta"}
+
+	tests := map[string]struct {
+		name string
+		args args
+		want want
+	}{
+		"group_default": {
+			args: args{
+				heading: "flags",
+				items:   items,
+			},
+			want: want{
+				model: func(g toggle.Group) {
+					assert.Empty(t, g.Checked())
+				},
+			},
+		},
+		"group_toggle": {
+			args: args{
+				heading: "flags",
+				items:   items,
+				model: func(g toggle.Group) toggle.Group {
+					g.Focus()
+					g, _ = g.Update(tea.KeyMsg{Type: tea.KeyDown})
+					g, _ = g.Update(uitest.KeyPress(' '))
+					g, _ = g.Update(uitest.KeyPress('j'))
+					g, _ = g.Update(uitest.KeyPress('j'))
+					g, _ = g.Update(uitest.KeyPress(' '))
+
+					return g
+				},
+			},
+			want: want{
+				model: func(g toggle.Group) {
+					assert.Equal(t, 3, g.Cursor())
+					assert.Equal(t, []string{"beta", "delta"}, g.Checked())
+				},
+			},
+		},
+		"group_animate": {
+			args: args{
+				heading: "flags",
+				items:   items,
+				model: func(g toggle.Group) toggle.Group {
+					var cmd tea.Cmd
+
+					for idx := range g.Items {
+						g.Items[idx].Appearance = toggle.Switch
+						g.Items[idx].Animate = true
+					}
+
+					g.Focus()
+					g, _ = g.Update(tea.KeyMsg{Type: tea.KeyDown})
+					g, cmd = g.Update(uitest.KeyPress(' '))
+
+					assert.NotNil(t, cmd)
+					assert.True(t, g.Items[1].Animating())
+
+					for cmd != nil {
+						g, cmd = g.Update(cmd())
+					}
+
+					return g
+				},
+			},
+			want: want{
+				model: func(g toggle.Group) {
+					assert.False(t, g.Items[1].Animating())
+					assert.Equal(t, []string{"beta"}, g.Checked())
+				},
+			},
+		},
+		"group_all": {
+			args: args{
+				heading: "flags",
+				items:   items,
+				model: func(g toggle.Group) toggle.Group {
+					g.Focus()
+					g, _ = g.Update(uitest.KeyPress('a'))
+
+					return g
+				},
+			},
+			want: want{
+				model: func(g toggle.Group) {
+					assert.Equal(t, items, g.Checked())
+				},
+			},
+		},
+		"group_none": {
+			args: args{
+				heading: "flags",
+				items:   items,
+				model: func(g toggle.Group) toggle.Group {
+					g.Focus()
+					g, _ = g.Update(uitest.KeyPress('a'))
+					g, _ = g.Update(uitest.KeyPress('n'))
+
+					return g
+				},
+			},
+			want: want{
+				model: func(g toggle.Group) {
+					assert.Empty(t, g.Checked())
+				},
+			},
+		},
+		"group_max": {
+			args: args{
+				heading: "flags",
+				items:   items,
+				max:     2,
+				model: func(g toggle.Group) toggle.Group {
+					g.Focus()
+					g, _ = g.Update(uitest.KeyPress('a'))
+					g, _ = g.Update(tea.KeyMsg{Type: tea.KeyUp})
+					g, _ = g.Update(uitest.KeyPress('j'))
+					g, _ = g.Update(uitest.KeyPress('j'))
+					g, _ = g.Update(uitest.KeyPress(' '))
+
+					return g
+				},
+			},
+			want: want{
+				model: func(g toggle.Group) {
+					assert.Equal(t, []string{"alpha", "beta"}, g.Checked())
+				},
+			},
+		},
+		"group_min": {
+			args: args{
+				heading: "flags",
+				items:   items,
+				min:     1,
+				model: func(g toggle.Group) toggle.Group {
+					g.Focus()
+					g, _ = g.Update(uitest.KeyPress('a'))
+					g, _ = g.Update(uitest.KeyPress('n'))
+					g, _ = g.Update(uitest.KeyPress(' '))
+
+					return g
+				},
+			},
+			want: want{
+				model: func(g toggle.Group) {
+					assert.Equal(t, []string{"alpha"}, g.Checked())
+				},
+			},
+		},
+		"group_blur": {
+			args: args{
+				heading: "flags",
+				items:   items,
+				model: func(g toggle.Group) toggle.Group {
+					g.Focus()
+					g, _ = g.Update(uitest.KeyPress(' '))
+					g.Blur()
+					g, _ = g.Update(uitest.KeyPress(' '))
+
+					return g
+				},
+			},
+			want: want{
+				model: func(g toggle.Group) {
+					assert.False(t, g.Focused())
+					assert.Equal(t, []string{"alpha"}, g.Checked())
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		name := name
+		tt := tt
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g := toggle.NewGroup(tt.args.items...)
+			g.Heading = tt.args.heading
+			g.Min = tt.args.min
+			g.Max = tt.args.max
+
+			if tt.args.model != nil {
+				g = tt.args.model(g)
+			}
+
+			if tt.want.model != nil {
+				tt.want.model(g)
+			}
+
+			v := uitest.StripString(g.View())
+			autogold.ExpectFile(t, autogold.Raw(v), autogold.Name(name))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package toggle
+
+// Value is the state of a tri-state toggle.
+type Value int
+
+const (
+	// Off is the disabled state.
+	Off Value = iota
+
+	// On is the enabled state.
+	On
+
+	// Indeterminate is neither enabled nor disabled.
+	Indeterminate
+)
+
+// defaultCycle is the order the tri-state toggle moves through.
+var defaultCycle = []Value{Off, On, Indeterminate}
+
+// String returns the name of the value.
+func (v Value) String() string {
+	switch v {
+	case On:
+		return "on"
+	case Indeterminate:
+		return "indeterminate"
+	default:
+		return "off"
+	}
+}
+
+// Value returns the state of the toggle. The state is only
+// indeterminate when the toggle is tri-state and not on.
+func (m Model) Value() Value {
+	switch {
+	case m.State:
+		return On
+	case m.TriState && m.indeterminate:
+		return Indeterminate
+	default:
+		return Off
+	}
+}
+
+// SetValue sets the state of the toggle. Setting indeterminate
+// has no effect unless the toggle is tri-state. The state of a
+// tri-state toggle must be set with SetValue as setting State to
+// false does not clear the indeterminate state.
+func (m *Model) SetValue(v Value) {
+	if v == Indeterminate && !m.TriState {
+		return
+	}
+
+	m.State = v == On
+	m.indeterminate = v == Indeterminate
+}
+
+// cycle moves the tri-state toggle to the next state in the cycle.
+// A state missing from the cycle moves to the first state.
+func (m *Model) cycle() {
+	order := m.Cycle
+	if len(order) == 0 {
+		order = defaultCycle
+	}
+
+	current := m.Value()
+
+	for idx, v := range order {
+		if v == current {
+			m.SetValue(order[(idx+1)%len(order)])
+
+			return
+		}
+	}
+
+	m.SetValue(order[0])
+}